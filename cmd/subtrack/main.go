@@ -10,9 +10,12 @@ import (
 	"time"
 
 	adapterhttp "github.com/maket12/SubTrack/internal/adapter/in/http"
+	adapterjob "github.com/maket12/SubTrack/internal/adapter/in/job"
 	adapterdb "github.com/maket12/SubTrack/internal/adapter/out/db"
+	adapternotify "github.com/maket12/SubTrack/internal/adapter/out/notify"
 	"github.com/maket12/SubTrack/internal/app/usecase"
 	"github.com/maket12/SubTrack/internal/config"
+	"github.com/maket12/SubTrack/internal/domain/entity"
 
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -66,16 +69,117 @@ func main() {
 	// 4. Repositories
 	// ======================
 	subRepo := adapterdb.NewSubscriptionRepo(db)
+	rateRepo := adapterdb.NewExchangeRateRepo(db)
+	chargeRepo := adapterdb.NewChargeRepo(db)
+	budgetRepo := adapterdb.NewBudgetRepo(db)
+	serviceRepo := adapterdb.NewServiceRepo(db)
+	costCentreRepo := adapterdb.NewCostCentreRepo(db)
+	vendorRepo := adapterdb.NewVendorRepo(db)
+	oneOffRepo := adapterdb.NewOneOffChargeRepo(db)
+	budgetNotifier := adapternotify.NewLogNotifier(logger)
 
 	// ======================
 	// 5. Usecases
 	// ======================
-	createUC := &usecase.CreateSubscriptionUC{Subscriptions: subRepo}
+	terminationFees := entity.TerminationFeePolicy{
+		Rule:       cfg.TerminationFeeRule,
+		FlatAmount: cfg.TerminationFeeFlat,
+	}
+	createUC := &usecase.CreateSubscriptionUC{
+		Subscriptions: subRepo,
+		Services:      serviceRepo,
+		Vendors:       vendorRepo,
+		Charges:       chargeRepo,
+		Budgets:       budgetRepo,
+		Rates:         rateRepo,
+		Notifier:      budgetNotifier,
+		Log:           logger,
+
+		RequireApproval: cfg.RequireApproval,
+	}
 	getUC := &usecase.GetSubscriptionUC{Subscriptions: subRepo}
-	updateUC := &usecase.UpdateSubscriptionUC{Subscriptions: subRepo}
+	updateUC := &usecase.UpdateSubscriptionUC{
+		Subscriptions: subRepo,
+		Services:      serviceRepo,
+		Vendors:       vendorRepo,
+		Charges:       chargeRepo,
+		Budgets:       budgetRepo,
+		Rates:         rateRepo,
+		Notifier:      budgetNotifier,
+		Log:           logger,
+
+		TerminationFees: terminationFees,
+	}
 	deleteUC := &usecase.DeleteSubscriptionUC{Subscriptions: subRepo}
 	listUC := &usecase.GetSubscriptionListUC{Subscriptions: subRepo}
-	totalSumUC := &usecase.GetTotalSumUC{Subscriptions: subRepo}
+	totalSumUC := &usecase.GetTotalSumUC{Subscriptions: subRepo, Rates: rateRepo}
+	pricesUC := &usecase.GetPriceHistoryUC{Subscriptions: subRepo}
+	pauseUC := &usecase.PauseSubscriptionUC{Subscriptions: subRepo, Charges: chargeRepo, Log: logger}
+	resumeUC := &usecase.ResumeSubscriptionUC{Subscriptions: subRepo, Charges: chargeRepo, Log: logger}
+	cancelUC := &usecase.CancelSubscriptionUC{
+		Subscriptions: subRepo,
+		Charges:       chargeRepo,
+		Log:           logger,
+
+		TerminationFees: terminationFees,
+	}
+	upcomingUC := &usecase.GetUpcomingRenewalsUC{Subscriptions: subRepo}
+	seriesUC := &usecase.GetTimeseriesUC{Subscriptions: subRepo, Rates: rateRepo}
+	forecastUC := &usecase.GetForecastUC{Subscriptions: subRepo, Rates: rateRepo}
+	dupesUC := &usecase.GetDuplicatesUC{Subscriptions: subRepo}
+	addMemberUC := &usecase.AddMemberUC{Subscriptions: subRepo}
+	removeMemberUC := &usecase.RemoveMemberUC{Subscriptions: subRepo}
+	seatsUC := &usecase.ChangeSeatsUC{
+		Subscriptions: subRepo,
+		Services:      serviceRepo,
+		Charges:       chargeRepo,
+		Budgets:       budgetRepo,
+		Rates:         rateRepo,
+		Notifier:      budgetNotifier,
+		Log:           logger,
+	}
+	allocateUC := &usecase.AllocateSubscriptionUC{Subscriptions: subRepo, CostCentres: costCentreRepo}
+	reviewUC := &usecase.ReviewSubscriptionUC{
+		Subscriptions: subRepo,
+		Services:      serviceRepo,
+		Charges:       chargeRepo,
+		Budgets:       budgetRepo,
+		Rates:         rateRepo,
+		Notifier:      budgetNotifier,
+		Log:           logger,
+	}
+	approvalsUC := &usecase.GetApprovalsUC{Subscriptions: subRepo}
+	noticeUC := &usecase.GetNoticeDeadlinesUC{Subscriptions: subRepo}
+	importRatesUC := &usecase.ImportExchangeRatesUC{Rates: rateRepo}
+	chargeListUC := &usecase.GetChargeListUC{Charges: chargeRepo}
+	extendChargesUC := &usecase.ExtendChargesUC{Charges: chargeRepo}
+	createBudgetUC := &usecase.CreateBudgetUC{Budgets: budgetRepo}
+	getBudgetUC := &usecase.GetBudgetUC{Budgets: budgetRepo}
+	updateBudgetUC := &usecase.UpdateBudgetUC{Budgets: budgetRepo}
+	deleteBudgetUC := &usecase.DeleteBudgetUC{Budgets: budgetRepo}
+	budgetListUC := &usecase.GetBudgetListUC{Budgets: budgetRepo}
+	budgetStatusUC := &usecase.GetBudgetStatusUC{Budgets: budgetRepo, Subscriptions: subRepo, Rates: rateRepo}
+	createServiceUC := &usecase.CreateServiceUC{Services: serviceRepo}
+	getServiceUC := &usecase.GetServiceUC{Services: serviceRepo}
+	updateServiceUC := &usecase.UpdateServiceUC{Services: serviceRepo}
+	deleteServiceUC := &usecase.DeleteServiceUC{Services: serviceRepo}
+	serviceListUC := &usecase.GetServiceListUC{Services: serviceRepo}
+	createCostCentreUC := &usecase.CreateCostCentreUC{CostCentres: costCentreRepo}
+	getCostCentreUC := &usecase.GetCostCentreUC{CostCentres: costCentreRepo}
+	updateCostCentreUC := &usecase.UpdateCostCentreUC{CostCentres: costCentreRepo}
+	deleteCostCentreUC := &usecase.DeleteCostCentreUC{CostCentres: costCentreRepo}
+	costCentreListUC := &usecase.GetCostCentreListUC{CostCentres: costCentreRepo}
+	chargebackUC := &usecase.GetChargebackUC{Subscriptions: subRepo, Rates: rateRepo}
+	createVendorUC := &usecase.CreateVendorUC{Vendors: vendorRepo}
+	getVendorUC := &usecase.GetVendorUC{Vendors: vendorRepo}
+	updateVendorUC := &usecase.UpdateVendorUC{Vendors: vendorRepo}
+	deleteVendorUC := &usecase.DeleteVendorUC{Vendors: vendorRepo}
+	vendorListUC := &usecase.GetVendorListUC{Vendors: vendorRepo}
+	createOneOffUC := &usecase.CreateOneOffChargeUC{Charges: oneOffRepo, Subscriptions: subRepo}
+	getOneOffUC := &usecase.GetOneOffChargeUC{Charges: oneOffRepo}
+	updateOneOffUC := &usecase.UpdateOneOffChargeUC{Charges: oneOffRepo, Subscriptions: subRepo}
+	deleteOneOffUC := &usecase.DeleteOneOffChargeUC{Charges: oneOffRepo}
+	oneOffListUC := &usecase.GetOneOffChargeListUC{Charges: oneOffRepo}
 
 	// ======================
 	// 6. Handlers (REST)
@@ -88,12 +192,80 @@ func main() {
 		deleteUC,
 		listUC,
 		totalSumUC,
+		pricesUC,
+		pauseUC,
+		resumeUC,
+		cancelUC,
+		upcomingUC,
+		seriesUC,
+		forecastUC,
+		dupesUC,
+		addMemberUC,
+		removeMemberUC,
+		seatsUC,
+		allocateUC,
+		reviewUC,
+		approvalsUC,
+		noticeUC,
+	)
+	rateHandler := adapterhttp.NewExchangeRateHandler(logger, importRatesUC)
+	chargeHandler := adapterhttp.NewChargeHandler(logger, chargeListUC)
+	budgetHandler := adapterhttp.NewBudgetHandler(
+		logger,
+		createBudgetUC,
+		getBudgetUC,
+		updateBudgetUC,
+		deleteBudgetUC,
+		budgetListUC,
+		budgetStatusUC,
+	)
+	serviceHandler := adapterhttp.NewServiceHandler(
+		logger,
+		createServiceUC,
+		getServiceUC,
+		updateServiceUC,
+		deleteServiceUC,
+		serviceListUC,
+	)
+	costCentreHandler := adapterhttp.NewCostCentreHandler(
+		logger,
+		createCostCentreUC,
+		getCostCentreUC,
+		updateCostCentreUC,
+		deleteCostCentreUC,
+		costCentreListUC,
+		chargebackUC,
+	)
+	vendorHandler := adapterhttp.NewVendorHandler(
+		logger,
+		createVendorUC,
+		getVendorUC,
+		updateVendorUC,
+		deleteVendorUC,
+		vendorListUC,
+	)
+	oneOffHandler := adapterhttp.NewOneOffChargeHandler(
+		logger,
+		createOneOffUC,
+		getOneOffUC,
+		updateOneOffUC,
+		deleteOneOffUC,
+		oneOffListUC,
 	)
 
 	// ======================
 	// 7. Router
 	// ======================
-	router := adapterhttp.NewRouter(subHandler).InitRoutes()
+	router := adapterhttp.NewRouter(
+		subHandler,
+		rateHandler,
+		chargeHandler,
+		budgetHandler,
+		serviceHandler,
+		costCentreHandler,
+		vendorHandler,
+		oneOffHandler,
+	).InitRoutes()
 
 	router.StaticFile("/swagger.yaml", "./docs/swagger.yaml")
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(
@@ -102,7 +274,15 @@ func main() {
 	))
 
 	// ======================
-	// 8. Run HTTP server
+	// 8. Background jobs
+	// ======================
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+
+	chargesJob := adapterjob.NewChargesJob(logger, cfg.ChargesJobInterval, extendChargesUC)
+	go chargesJob.Run(jobsCtx)
+
+	// ======================
+	// 9. Run HTTP server
 	// ======================
 
 	srv := &http.Server{
@@ -131,6 +311,8 @@ func main() {
 		logger.Error("server forced to shutdown", slog.Any("err", err))
 	}
 
+	stopJobs()
+
 	if err := db.Close(); err != nil {
 		logger.Error("failed to close database", slog.Any("err", err))
 	}
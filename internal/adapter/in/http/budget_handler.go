@@ -0,0 +1,193 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BudgetHandler struct {
+	log      *slog.Logger
+	CreateUC *usecase.CreateBudgetUC
+	GetUC    *usecase.GetBudgetUC
+	UpdateUC *usecase.UpdateBudgetUC
+	DeleteUC *usecase.DeleteBudgetUC
+	ListUC   *usecase.GetBudgetListUC
+	StatusUC *usecase.GetBudgetStatusUC
+}
+
+func NewBudgetHandler(
+	log *slog.Logger,
+	createUC *usecase.CreateBudgetUC,
+	getUC *usecase.GetBudgetUC,
+	updateUC *usecase.UpdateBudgetUC,
+	deleteUC *usecase.DeleteBudgetUC,
+	listUC *usecase.GetBudgetListUC,
+	statusUC *usecase.GetBudgetStatusUC,
+) *BudgetHandler {
+	return &BudgetHandler{
+		log:      log,
+		CreateUC: createUC,
+		GetUC:    getUC,
+		UpdateUC: updateUC,
+		DeleteUC: deleteUC,
+		ListUC:   listUC,
+		StatusUC: statusUC,
+	}
+}
+
+func (h *BudgetHandler) Create(ctx *gin.Context) {
+	var req dto.CreateBudget
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+
+	resp, err := h.CreateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to create budget",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "created budget",
+		slog.Int("id", resp.ID),
+	)
+
+	ctx.JSON(http.StatusCreated, resp)
+}
+
+func (h *BudgetHandler) GetByID(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.GetUC.Execute(ctx, dto.GetBudget{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get budget",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *BudgetHandler) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.UpdateBudget
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+
+	resp, err := h.UpdateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to update budget",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "updated budget",
+		slog.Int("id", req.ID),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *BudgetHandler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.DeleteUC.Execute(ctx, dto.DeleteBudget{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to delete budget",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "deleted budget",
+		slog.Int("id", id),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *BudgetHandler) List(ctx *gin.Context) {
+	var req dto.GetBudgetList
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.ListUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get budget list",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *BudgetHandler) Status(ctx *gin.Context) {
+	var req dto.GetBudgetStatus
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.StatusUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get budget status",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
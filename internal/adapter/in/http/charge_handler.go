@@ -0,0 +1,48 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChargeHandler struct {
+	log    *slog.Logger
+	ListUC *usecase.GetChargeListUC
+}
+
+func NewChargeHandler(
+	log *slog.Logger,
+	listUC *usecase.GetChargeListUC,
+) *ChargeHandler {
+	return &ChargeHandler{
+		log:    log,
+		ListUC: listUC,
+	}
+}
+
+func (h *ChargeHandler) List(ctx *gin.Context) {
+	var req dto.GetChargeList
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.ListUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get charges",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
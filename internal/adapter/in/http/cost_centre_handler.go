@@ -0,0 +1,193 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CostCentreHandler struct {
+	log          *slog.Logger
+	CreateUC     *usecase.CreateCostCentreUC
+	GetUC        *usecase.GetCostCentreUC
+	UpdateUC     *usecase.UpdateCostCentreUC
+	DeleteUC     *usecase.DeleteCostCentreUC
+	ListUC       *usecase.GetCostCentreListUC
+	ChargebackUC *usecase.GetChargebackUC
+}
+
+func NewCostCentreHandler(
+	log *slog.Logger,
+	createUC *usecase.CreateCostCentreUC,
+	getUC *usecase.GetCostCentreUC,
+	updateUC *usecase.UpdateCostCentreUC,
+	deleteUC *usecase.DeleteCostCentreUC,
+	listUC *usecase.GetCostCentreListUC,
+	chargebackUC *usecase.GetChargebackUC,
+) *CostCentreHandler {
+	return &CostCentreHandler{
+		log:          log,
+		CreateUC:     createUC,
+		GetUC:        getUC,
+		UpdateUC:     updateUC,
+		DeleteUC:     deleteUC,
+		ListUC:       listUC,
+		ChargebackUC: chargebackUC,
+	}
+}
+
+func (h *CostCentreHandler) Create(ctx *gin.Context) {
+	var req dto.CreateCostCentre
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+
+	resp, err := h.CreateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to create cost centre",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "created cost centre",
+		slog.Int("id", resp.ID),
+	)
+
+	ctx.JSON(http.StatusCreated, resp)
+}
+
+func (h *CostCentreHandler) GetByID(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.GetUC.Execute(ctx, dto.GetCostCentre{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get cost centre",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *CostCentreHandler) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.UpdateCostCentre
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+
+	resp, err := h.UpdateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to update cost centre",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "updated cost centre",
+		slog.Int("id", req.ID),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *CostCentreHandler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.DeleteUC.Execute(ctx, dto.DeleteCostCentre{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to delete cost centre",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "deleted cost centre",
+		slog.Int("id", id),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *CostCentreHandler) List(ctx *gin.Context) {
+	var req dto.GetCostCentreList
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.ListUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get cost centre list",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *CostCentreHandler) Chargeback(ctx *gin.Context) {
+	var req dto.GetChargeback
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.ChargebackUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get chargeback",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -5,19 +5,82 @@ import (
 	"net/http"
 
 	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+
+	"github.com/gin-gonic/gin"
 )
 
 func HttpError(err error) (int, string, error) {
+	var verr *entity.ValidationError
+	if errors.As(err, &verr) {
+		return http.StatusBadRequest, verr.Error(), nil
+	}
+
 	if w, ok := err.(*uc_errors.WrappedError); ok {
 		switch w.Public {
-		case uc_errors.ErrSubscriptionNotFound:
+		case uc_errors.ErrSubscriptionNotFound,
+			uc_errors.ErrBudgetNotFound,
+			uc_errors.ErrServiceNotFound,
+			uc_errors.ErrMemberNotFound,
+			uc_errors.ErrCostCentreNotFound,
+			uc_errors.ErrVendorNotFound,
+			uc_errors.ErrOneOffChargeNotFound:
 			return http.StatusNotFound, w.Public.Error(), w.Reason
+		case uc_errors.ErrExchangeRateNotFound:
+			return http.StatusUnprocessableEntity, w.Public.Error(), w.Reason
 		case uc_errors.ErrCreateSubscription,
 			uc_errors.ErrGetSubscription,
 			uc_errors.ErrUpdateSubscription,
 			uc_errors.ErrDeleteSubscription,
 			uc_errors.ErrGetSubscriptionList,
-			uc_errors.ErrGetTotalSum:
+			uc_errors.ErrGetTotalSum,
+			uc_errors.ErrGetExchangeRate,
+			uc_errors.ErrSaveExchangeRates,
+			uc_errors.ErrGetPriceHistory,
+			uc_errors.ErrPauseSubscription,
+			uc_errors.ErrResumeSubscription,
+			uc_errors.ErrCancelSubscription,
+			uc_errors.ErrGetUpcomingRenewals,
+			uc_errors.ErrExtendCharges,
+			uc_errors.ErrGetCharges,
+			uc_errors.ErrGetTimeseries,
+			uc_errors.ErrGetForecast,
+			uc_errors.ErrCreateBudget,
+			uc_errors.ErrGetBudget,
+			uc_errors.ErrUpdateBudget,
+			uc_errors.ErrDeleteBudget,
+			uc_errors.ErrGetBudgetList,
+			uc_errors.ErrCheckBudgets,
+			uc_errors.ErrCreateService,
+			uc_errors.ErrGetService,
+			uc_errors.ErrUpdateService,
+			uc_errors.ErrDeleteService,
+			uc_errors.ErrGetServiceList,
+			uc_errors.ErrCheckOverlap,
+			uc_errors.ErrGetDuplicates,
+			uc_errors.ErrAddMember,
+			uc_errors.ErrRemoveMember,
+			uc_errors.ErrChangeSeats,
+			uc_errors.ErrCreateCostCentre,
+			uc_errors.ErrGetCostCentre,
+			uc_errors.ErrUpdateCostCentre,
+			uc_errors.ErrDeleteCostCentre,
+			uc_errors.ErrGetCostCentreList,
+			uc_errors.ErrAllocateSubscription,
+			uc_errors.ErrGetChargeback,
+			uc_errors.ErrReviewSubscription,
+			uc_errors.ErrGetApprovals,
+			uc_errors.ErrCreateVendor,
+			uc_errors.ErrGetVendor,
+			uc_errors.ErrUpdateVendor,
+			uc_errors.ErrDeleteVendor,
+			uc_errors.ErrGetVendorList,
+			uc_errors.ErrGetNoticeDeadlines,
+			uc_errors.ErrCreateOneOffCharge,
+			uc_errors.ErrGetOneOffCharge,
+			uc_errors.ErrUpdateOneOffCharge,
+			uc_errors.ErrDeleteOneOffCharge,
+			uc_errors.ErrGetOneOffChargeList:
 			return http.StatusInternalServerError, w.Public.Error(), w.Reason
 		default:
 			return http.StatusInternalServerError, "internal error", w.Reason
@@ -27,6 +90,20 @@ func HttpError(err error) (int, string, error) {
 	switch {
 	case errors.Is(err, uc_errors.ErrSubscriptionNotFound):
 		return http.StatusNotFound, err.Error(), nil
+	case errors.Is(err, uc_errors.ErrSubscriptionPaused),
+		errors.Is(err, uc_errors.ErrSubscriptionNotPaused),
+		errors.Is(err, uc_errors.ErrPauseOverlap),
+		errors.Is(err, uc_errors.ErrSubscriptionCancelled),
+		errors.Is(err, uc_errors.ErrSubscriptionEnded),
+		errors.Is(err, uc_errors.ErrServiceNameTaken),
+		errors.Is(err, uc_errors.ErrSubscriptionOverlap),
+		errors.Is(err, uc_errors.ErrMemberIsOwner),
+		errors.Is(err, uc_errors.ErrSharesOverTotal),
+		errors.Is(err, uc_errors.ErrCostCentreNameTaken),
+		errors.Is(err, uc_errors.ErrSubscriptionNotPending),
+		errors.Is(err, uc_errors.ErrVendorNameTaken),
+		errors.Is(err, uc_errors.ErrTerminationFeeCharge):
+		return http.StatusConflict, err.Error(), nil
 	case errors.Is(err, uc_errors.ErrEmptyServiceName),
 		errors.Is(err, uc_errors.ErrInvalidDate),
 		errors.Is(err, uc_errors.ErrEmptyDate),
@@ -34,9 +111,66 @@ func HttpError(err error) (int, string, error) {
 		errors.Is(err, uc_errors.ErrInvalidUserID),
 		errors.Is(err, uc_errors.ErrInvalidSubscriptionID),
 		errors.Is(err, uc_errors.ErrInvalidLimit),
-		errors.Is(err, uc_errors.ErrInvalidOffset):
+		errors.Is(err, uc_errors.ErrInvalidOffset),
+		errors.Is(err, uc_errors.ErrInvalidPeriod),
+		errors.Is(err, uc_errors.ErrInvalidBillingPeriod),
+		errors.Is(err, uc_errors.ErrInvalidCurrency),
+		errors.Is(err, uc_errors.ErrInvalidExchangeRates),
+		errors.Is(err, uc_errors.ErrEmptyExchangeRates),
+		errors.Is(err, uc_errors.ErrInvalidPauseDate),
+		errors.Is(err, uc_errors.ErrInvalidResumeDate),
+		errors.Is(err, uc_errors.ErrEmptyCancellationReason),
+		errors.Is(err, uc_errors.ErrInvalidWithin),
+		errors.Is(err, uc_errors.ErrInvalidGroupBy),
+		errors.Is(err, uc_errors.ErrInvalidMonths),
+		errors.Is(err, uc_errors.ErrTimeseriesTooLong),
+		errors.Is(err, uc_errors.ErrInvalidBudgetID),
+		errors.Is(err, uc_errors.ErrInvalidMonthlyLimit),
+		errors.Is(err, uc_errors.ErrInvalidServiceID),
+		errors.Is(err, uc_errors.ErrEmptyServiceAlias),
+		errors.Is(err, uc_errors.ErrEmptyTag),
+		errors.Is(err, uc_errors.ErrInvalidShare),
+		errors.Is(err, uc_errors.ErrInvalidCostCentreID),
+		errors.Is(err, uc_errors.ErrEmptyCostCentreName),
+		errors.Is(err, uc_errors.ErrInvalidAllocation),
+		errors.Is(err, uc_errors.ErrAllocationsOverTotal),
+		errors.Is(err, uc_errors.ErrInvalidMonth),
+		errors.Is(err, uc_errors.ErrEmptyApproverID),
+		errors.Is(err, uc_errors.ErrInvalidApproverID),
+		errors.Is(err, uc_errors.ErrEmptyRejectionComment),
+		errors.Is(err, uc_errors.ErrInvalidVendorID),
+		errors.Is(err, uc_errors.ErrEmptyVendorName),
+		errors.Is(err, uc_errors.ErrInvalidRenewalOwnerID),
+		errors.Is(err, uc_errors.ErrInvalidOneOffChargeID),
+		errors.Is(err, uc_errors.ErrNoChargeOwner):
 		return http.StatusBadRequest, err.Error(), nil
 	}
 
 	return http.StatusInternalServerError, "internal error", err
 }
+
+// errorBody is the response body for err, the public message msg from
+// HttpError along with the details some errors carry.
+func errorBody(err error, msg string) gin.H {
+	body := gin.H{"error": msg}
+
+	var verr *entity.ValidationError
+	if errors.As(err, &verr) {
+		fields := make([]gin.H, len(verr.Fields))
+		for i, f := range verr.Fields {
+			fields[i] = gin.H{
+				"field":   f.Field,
+				"code":    f.Code,
+				"message": f.Err.Error(),
+			}
+		}
+		body["fields"] = fields
+	}
+
+	var overlap *uc_errors.OverlapError
+	if errors.As(err, &overlap) {
+		body["conflicting_id"] = overlap.ConflictingID
+	}
+
+	return body
+}
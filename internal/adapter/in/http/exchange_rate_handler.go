@@ -0,0 +1,47 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExchangeRateHandler struct {
+	log      *slog.Logger
+	ImportUC *usecase.ImportExchangeRatesUC
+}
+
+func NewExchangeRateHandler(
+	log *slog.Logger,
+	importUC *usecase.ImportExchangeRatesUC,
+) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		log:      log,
+		ImportUC: importUC,
+	}
+}
+
+// Import loads exchange rates from a CSV request body.
+func (h *ExchangeRateHandler) Import(ctx *gin.Context) {
+	resp, err := h.ImportUC.Execute(ctx, dto.ImportExchangeRates{Data: ctx.Request.Body})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to import exchange rates",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "imported exchange rates",
+		slog.Int("count", resp.Imported),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
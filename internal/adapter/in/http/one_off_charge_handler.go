@@ -0,0 +1,168 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OneOffChargeHandler struct {
+	log      *slog.Logger
+	CreateUC *usecase.CreateOneOffChargeUC
+	GetUC    *usecase.GetOneOffChargeUC
+	UpdateUC *usecase.UpdateOneOffChargeUC
+	DeleteUC *usecase.DeleteOneOffChargeUC
+	ListUC   *usecase.GetOneOffChargeListUC
+}
+
+func NewOneOffChargeHandler(
+	log *slog.Logger,
+	createUC *usecase.CreateOneOffChargeUC,
+	getUC *usecase.GetOneOffChargeUC,
+	updateUC *usecase.UpdateOneOffChargeUC,
+	deleteUC *usecase.DeleteOneOffChargeUC,
+	listUC *usecase.GetOneOffChargeListUC,
+) *OneOffChargeHandler {
+	return &OneOffChargeHandler{
+		log:      log,
+		CreateUC: createUC,
+		GetUC:    getUC,
+		UpdateUC: updateUC,
+		DeleteUC: deleteUC,
+		ListUC:   listUC,
+	}
+}
+
+func (h *OneOffChargeHandler) Create(ctx *gin.Context) {
+	var req dto.CreateOneOffCharge
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+
+	resp, err := h.CreateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to create one-off charge",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "created one-off charge",
+		slog.Int("id", resp.ID),
+	)
+
+	ctx.JSON(http.StatusCreated, resp)
+}
+
+func (h *OneOffChargeHandler) GetByID(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.GetUC.Execute(ctx, dto.GetOneOffCharge{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get one-off charge",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *OneOffChargeHandler) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.UpdateOneOffCharge
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+
+	resp, err := h.UpdateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to update one-off charge",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "updated one-off charge",
+		slog.Int("id", req.ID),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *OneOffChargeHandler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.DeleteUC.Execute(ctx, dto.DeleteOneOffCharge{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to delete one-off charge",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "deleted one-off charge",
+		slog.Int("id", id),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *OneOffChargeHandler) List(ctx *gin.Context) {
+	var req dto.GetOneOffChargeList
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.ListUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get one-off charge list",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -6,11 +6,34 @@ import (
 
 type Router struct {
 	Subscription *SubscriptionHandler
+	ExchangeRate *ExchangeRateHandler
+	Charge       *ChargeHandler
+	Budget       *BudgetHandler
+	Service      *ServiceHandler
+	CostCentre   *CostCentreHandler
+	Vendor       *VendorHandler
+	OneOffCharge *OneOffChargeHandler
 }
 
-func NewRouter(sub *SubscriptionHandler) *Router {
+func NewRouter(
+	sub *SubscriptionHandler,
+	rates *ExchangeRateHandler,
+	charges *ChargeHandler,
+	budgets *BudgetHandler,
+	services *ServiceHandler,
+	costCentres *CostCentreHandler,
+	vendors *VendorHandler,
+	oneOffCharges *OneOffChargeHandler,
+) *Router {
 	return &Router{
 		Subscription: sub,
+		ExchangeRate: rates,
+		Charge:       charges,
+		Budget:       budgets,
+		Service:      services,
+		CostCentre:   costCentres,
+		Vendor:       vendors,
+		OneOffCharge: oneOffCharges,
 	}
 }
 
@@ -28,6 +51,83 @@ func (r *Router) InitRoutes() *gin.Engine {
 		api.DELETE("/:id", r.Subscription.Delete)
 		api.GET("", r.Subscription.List)
 		api.GET("/total", r.Subscription.GetTotalSum)
+		api.GET("/upcoming", r.Subscription.GetUpcomingRenewals)
+		api.GET("/timeseries", r.Subscription.GetTimeseries)
+		api.GET("/forecast", r.Subscription.GetForecast)
+		api.GET("/duplicates", r.Subscription.GetDuplicates)
+		api.GET("/notice-deadlines", r.Subscription.GetNoticeDeadlines)
+		api.GET("/:id/prices", r.Subscription.GetPriceHistory)
+		api.POST("/:id/pause", r.Subscription.Pause)
+		api.POST("/:id/resume", r.Subscription.Resume)
+		api.POST("/:id/cancel", r.Subscription.Cancel)
+		api.POST("/:id/seats", r.Subscription.ChangeSeats)
+		api.POST("/:id/members", r.Subscription.AddMember)
+		api.DELETE("/:id/members/:user_id", r.Subscription.RemoveMember)
+		api.PUT("/:id/allocations", r.Subscription.Allocate)
+		api.POST("/:id/approve", r.Subscription.Approve)
+		api.POST("/:id/reject", r.Subscription.Reject)
+		api.GET("/:id/approvals", r.Subscription.GetApprovals)
+	}
+
+	charges := router.Group("/charges")
+	{
+		charges.GET("", r.Charge.List)
+	}
+
+	budgets := router.Group("/budgets")
+	{
+		budgets.POST("", r.Budget.Create)
+		budgets.GET("", r.Budget.List)
+		budgets.GET("/status", r.Budget.Status)
+		budgets.GET("/:id", r.Budget.GetByID)
+		budgets.PUT("/:id", r.Budget.Update)
+		budgets.DELETE("/:id", r.Budget.Delete)
+	}
+
+	services := router.Group("/services")
+	{
+		services.POST("", r.Service.Create)
+		services.GET("", r.Service.List)
+		services.GET("/:id", r.Service.GetByID)
+		services.PUT("/:id", r.Service.Update)
+		services.DELETE("/:id", r.Service.Delete)
+	}
+
+	costCentres := router.Group("/cost-centres")
+	{
+		costCentres.POST("", r.CostCentre.Create)
+		costCentres.GET("", r.CostCentre.List)
+		costCentres.GET("/:id", r.CostCentre.GetByID)
+		costCentres.PUT("/:id", r.CostCentre.Update)
+		costCentres.DELETE("/:id", r.CostCentre.Delete)
+	}
+
+	vendors := router.Group("/vendors")
+	{
+		vendors.POST("", r.Vendor.Create)
+		vendors.GET("", r.Vendor.List)
+		vendors.GET("/:id", r.Vendor.GetByID)
+		vendors.PUT("/:id", r.Vendor.Update)
+		vendors.DELETE("/:id", r.Vendor.Delete)
+	}
+
+	oneOffCharges := router.Group("/one-off-charges")
+	{
+		oneOffCharges.POST("", r.OneOffCharge.Create)
+		oneOffCharges.GET("", r.OneOffCharge.List)
+		oneOffCharges.GET("/:id", r.OneOffCharge.GetByID)
+		oneOffCharges.PUT("/:id", r.OneOffCharge.Update)
+		oneOffCharges.DELETE("/:id", r.OneOffCharge.Delete)
+	}
+
+	reports := router.Group("/reports")
+	{
+		reports.GET("/chargeback", r.CostCentre.Chargeback)
+	}
+
+	admin := router.Group("/admin")
+	{
+		admin.POST("/exchange-rates", r.ExchangeRate.Import)
 	}
 
 	return router
@@ -0,0 +1,168 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ServiceHandler struct {
+	log      *slog.Logger
+	CreateUC *usecase.CreateServiceUC
+	GetUC    *usecase.GetServiceUC
+	UpdateUC *usecase.UpdateServiceUC
+	DeleteUC *usecase.DeleteServiceUC
+	ListUC   *usecase.GetServiceListUC
+}
+
+func NewServiceHandler(
+	log *slog.Logger,
+	createUC *usecase.CreateServiceUC,
+	getUC *usecase.GetServiceUC,
+	updateUC *usecase.UpdateServiceUC,
+	deleteUC *usecase.DeleteServiceUC,
+	listUC *usecase.GetServiceListUC,
+) *ServiceHandler {
+	return &ServiceHandler{
+		log:      log,
+		CreateUC: createUC,
+		GetUC:    getUC,
+		UpdateUC: updateUC,
+		DeleteUC: deleteUC,
+		ListUC:   listUC,
+	}
+}
+
+func (h *ServiceHandler) Create(ctx *gin.Context) {
+	var req dto.CreateService
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+
+	resp, err := h.CreateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to create service",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "created service",
+		slog.Int("id", resp.ID),
+	)
+
+	ctx.JSON(http.StatusCreated, resp)
+}
+
+func (h *ServiceHandler) GetByID(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.GetUC.Execute(ctx, dto.GetService{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get service",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *ServiceHandler) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.UpdateService
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+
+	resp, err := h.UpdateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to update service",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "updated service",
+		slog.Int("id", req.ID),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *ServiceHandler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.DeleteUC.Execute(ctx, dto.DeleteService{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to delete service",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "deleted service",
+		slog.Int("id", id),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *ServiceHandler) List(ctx *gin.Context) {
+	var req dto.GetServiceList
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.ListUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get service list",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -12,13 +12,28 @@ import (
 )
 
 type SubscriptionHandler struct {
-	log        *slog.Logger
-	CreateUC   *usecase.CreateSubscriptionUC
-	GetUC      *usecase.GetSubscriptionUC
-	UpdateUC   *usecase.UpdateSubscriptionUC
-	DeleteUC   *usecase.DeleteSubscriptionUC
-	ListUC     *usecase.GetSubscriptionListUC
-	TotalSumUC *usecase.GetTotalSumUC
+	log            *slog.Logger
+	CreateUC       *usecase.CreateSubscriptionUC
+	GetUC          *usecase.GetSubscriptionUC
+	UpdateUC       *usecase.UpdateSubscriptionUC
+	DeleteUC       *usecase.DeleteSubscriptionUC
+	ListUC         *usecase.GetSubscriptionListUC
+	TotalSumUC     *usecase.GetTotalSumUC
+	PricesUC       *usecase.GetPriceHistoryUC
+	PauseUC        *usecase.PauseSubscriptionUC
+	ResumeUC       *usecase.ResumeSubscriptionUC
+	CancelUC       *usecase.CancelSubscriptionUC
+	UpcomingUC     *usecase.GetUpcomingRenewalsUC
+	SeriesUC       *usecase.GetTimeseriesUC
+	ForecastUC     *usecase.GetForecastUC
+	DupesUC        *usecase.GetDuplicatesUC
+	AddMemberUC    *usecase.AddMemberUC
+	RemoveMemberUC *usecase.RemoveMemberUC
+	SeatsUC        *usecase.ChangeSeatsUC
+	AllocateUC     *usecase.AllocateSubscriptionUC
+	ReviewUC       *usecase.ReviewSubscriptionUC
+	ApprovalsUC    *usecase.GetApprovalsUC
+	NoticeUC       *usecase.GetNoticeDeadlinesUC
 }
 
 func NewSubscriptionHandler(
@@ -29,15 +44,45 @@ func NewSubscriptionHandler(
 	deleteUC *usecase.DeleteSubscriptionUC,
 	listUC *usecase.GetSubscriptionListUC,
 	totalSumUC *usecase.GetTotalSumUC,
+	pricesUC *usecase.GetPriceHistoryUC,
+	pauseUC *usecase.PauseSubscriptionUC,
+	resumeUC *usecase.ResumeSubscriptionUC,
+	cancelUC *usecase.CancelSubscriptionUC,
+	upcomingUC *usecase.GetUpcomingRenewalsUC,
+	seriesUC *usecase.GetTimeseriesUC,
+	forecastUC *usecase.GetForecastUC,
+	dupesUC *usecase.GetDuplicatesUC,
+	addMemberUC *usecase.AddMemberUC,
+	removeMemberUC *usecase.RemoveMemberUC,
+	seatsUC *usecase.ChangeSeatsUC,
+	allocateUC *usecase.AllocateSubscriptionUC,
+	reviewUC *usecase.ReviewSubscriptionUC,
+	approvalsUC *usecase.GetApprovalsUC,
+	noticeUC *usecase.GetNoticeDeadlinesUC,
 ) *SubscriptionHandler {
 	return &SubscriptionHandler{
-		log:        log,
-		CreateUC:   createUC,
-		GetUC:      getUC,
-		UpdateUC:   updateUC,
-		DeleteUC:   deleteUC,
-		ListUC:     listUC,
-		TotalSumUC: totalSumUC,
+		log:            log,
+		CreateUC:       createUC,
+		GetUC:          getUC,
+		UpdateUC:       updateUC,
+		DeleteUC:       deleteUC,
+		ListUC:         listUC,
+		TotalSumUC:     totalSumUC,
+		PricesUC:       pricesUC,
+		PauseUC:        pauseUC,
+		ResumeUC:       resumeUC,
+		CancelUC:       cancelUC,
+		UpcomingUC:     upcomingUC,
+		SeriesUC:       seriesUC,
+		ForecastUC:     forecastUC,
+		DupesUC:        dupesUC,
+		AddMemberUC:    addMemberUC,
+		RemoveMemberUC: removeMemberUC,
+		SeatsUC:        seatsUC,
+		AllocateUC:     allocateUC,
+		ReviewUC:       reviewUC,
+		ApprovalsUC:    approvalsUC,
+		NoticeUC:       noticeUC,
 	}
 }
 
@@ -56,7 +101,8 @@ func (h *SubscriptionHandler) Create(ctx *gin.Context) {
 			slog.String("public_msg", msg),
 			slog.Any("cause", internalErr),
 		)
-		ctx.JSON(status, gin.H{"error": msg})
+
+		ctx.JSON(status, errorBody(err, msg))
 		return
 	}
 
@@ -82,7 +128,7 @@ func (h *SubscriptionHandler) GetByID(ctx *gin.Context) {
 			slog.String("public_msg", msg),
 			slog.Any("cause", internalErr),
 		)
-		ctx.JSON(status, gin.H{"error": msg})
+		ctx.JSON(status, errorBody(err, msg))
 		return
 	}
 
@@ -103,6 +149,12 @@ func (h *SubscriptionHandler) Update(ctx *gin.Context) {
 	}
 	req.ID = id
 
+	req.Confirm, err = confirmed(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "confirm must be a boolean"})
+		return
+	}
+
 	resp, err := h.UpdateUC.Execute(ctx, req)
 	if err != nil {
 		status, msg, internalErr := HttpError(err)
@@ -111,7 +163,7 @@ func (h *SubscriptionHandler) Update(ctx *gin.Context) {
 			slog.String("public_msg", msg),
 			slog.Any("cause", internalErr),
 		)
-		ctx.JSON(status, gin.H{"error": msg})
+		ctx.JSON(status, errorBody(err, msg))
 		return
 	}
 
@@ -137,7 +189,7 @@ func (h *SubscriptionHandler) Delete(ctx *gin.Context) {
 			slog.String("public_msg", msg),
 			slog.Any("cause", internalErr),
 		)
-		ctx.JSON(status, gin.H{"error": msg})
+		ctx.JSON(status, errorBody(err, msg))
 		return
 	}
 
@@ -163,7 +215,7 @@ func (h *SubscriptionHandler) List(ctx *gin.Context) {
 			slog.String("public_msg", msg),
 			slog.Any("cause", internalErr),
 		)
-		ctx.JSON(status, gin.H{"error": msg})
+		ctx.JSON(status, errorBody(err, msg))
 		return
 	}
 
@@ -185,9 +237,454 @@ func (h *SubscriptionHandler) GetTotalSum(ctx *gin.Context) {
 			slog.String("public_msg", msg),
 			slog.Any("cause", internalErr),
 		)
-		ctx.JSON(status, gin.H{"error": msg})
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) GetTimeseries(ctx *gin.Context) {
+	var req dto.GetTimeseries
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.SeriesUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get timeseries",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) GetForecast(ctx *gin.Context) {
+	var req dto.GetForecast
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.ForecastUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get forecast",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) GetDuplicates(ctx *gin.Context) {
+	var req dto.GetDuplicates
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.DupesUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get duplicates",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) GetUpcomingRenewals(ctx *gin.Context) {
+	var req dto.GetUpcomingRenewals
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.UpcomingUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get upcoming renewals",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) GetNoticeDeadlines(ctx *gin.Context) {
+	var req dto.GetNoticeDeadlines
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query"})
+		return
+	}
+
+	resp, err := h.NoticeUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get notice deadlines",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) GetPriceHistory(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.PricesUC.Execute(ctx, dto.GetPriceHistory{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get price history",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) Pause(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.PauseSubscription
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+			return
+		}
+	}
+	req.ID = id
+
+	resp, err := h.PauseUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to pause subscription",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) Resume(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.ResumeSubscription
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+			return
+		}
+	}
+	req.ID = id
+
+	resp, err := h.ResumeUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to resume subscription",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) Cancel(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.CancelSubscription
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+
+	req.Confirm, err = confirmed(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "confirm must be a boolean"})
+		return
+	}
+
+	resp, err := h.CancelUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to cancel subscription",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	// a cancellation awaiting confirmation of its fee has not happened yet
+	if resp.Cancelled {
+		h.log.InfoContext(ctx, "cancelled subscription",
+			slog.Int("id", id),
+			slog.String("end_date", resp.EndDate),
+		)
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) AddMember(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.AddMember
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+
+	resp, err := h.AddMemberUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to add subscription member",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "added subscription member",
+		slog.Int("id", id),
+		slog.String("user_id", req.UserID),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) RemoveMember(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	req := dto.RemoveMember{ID: id, UserID: ctx.Param("user_id")}
+
+	resp, err := h.RemoveMemberUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to remove subscription member",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "removed subscription member",
+		slog.Int("id", id),
+		slog.String("user_id", req.UserID),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) ChangeSeats(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.ChangeSeats
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+
+	resp, err := h.SeatsUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to change seats",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "changed seats",
+		slog.Int("id", id),
+		slog.Int("seats", req.Seats),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) Allocate(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.AllocateSubscription
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+
+	resp, err := h.AllocateUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to allocate subscription",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "allocated subscription",
+		slog.Int("id", id),
+		slog.Int("allocations", len(req.Allocations)),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) Approve(ctx *gin.Context) {
+	h.review(ctx, true)
+}
+
+func (h *SubscriptionHandler) Reject(ctx *gin.Context) {
+	h.review(ctx, false)
+}
+
+// review records the approver's decision on a pending subscription.
+func (h *SubscriptionHandler) review(ctx *gin.Context, approve bool) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	var req dto.ReviewSubscription
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid json"})
+		return
+	}
+	req.ID = id
+	req.Approve = approve
+
+	resp, err := h.ReviewUC.Execute(ctx, req)
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to review subscription",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
+		return
+	}
+
+	h.log.InfoContext(ctx, "reviewed subscription",
+		slog.Int("id", id),
+		slog.String("decision", resp.Decision),
+		slog.String("approver_id", req.ApproverID),
+	)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *SubscriptionHandler) GetApprovals(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "id must be positive integer"})
+		return
+	}
+
+	resp, err := h.ApprovalsUC.Execute(ctx, dto.GetApprovals{ID: id})
+	if err != nil {
+		status, msg, internalErr := HttpError(err)
+		h.log.ErrorContext(ctx, "failed to get approval decisions",
+			slog.Int("status", status),
+			slog.String("public_msg", msg),
+			slog.Any("cause", internalErr),
+		)
+		ctx.JSON(status, errorBody(err, msg))
 		return
 	}
 
 	ctx.JSON(http.StatusOK, resp)
 }
+
+// confirmed reads the confirm query flag, by which the caller accepts an early
+// termination fee shown before.
+func confirmed(ctx *gin.Context) (bool, error) {
+	v, ok := ctx.GetQuery("confirm")
+	if !ok {
+		return false, nil
+	}
+	return strconv.ParseBool(v)
+}
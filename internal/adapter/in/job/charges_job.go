@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/usecase"
+)
+
+// ChargesJob keeps the charges ledger filled in ahead of today.
+type ChargesJob struct {
+	log      *slog.Logger
+	interval time.Duration
+	ExtendUC *usecase.ExtendChargesUC
+}
+
+func NewChargesJob(
+	log *slog.Logger,
+	interval time.Duration,
+	extendUC *usecase.ExtendChargesUC,
+) *ChargesJob {
+	return &ChargesJob{
+		log:      log,
+		interval: interval,
+		ExtendUC: extendUC,
+	}
+}
+
+// Run extends the ledger right away and then once per interval until ctx is
+// done.
+func (j *ChargesJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.extend(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *ChargesJob) extend(ctx context.Context) {
+	resp, err := j.ExtendUC.Execute(ctx)
+	if err != nil {
+		j.log.ErrorContext(ctx, "failed to extend charges ledger",
+			slog.Any("cause", err),
+		)
+		return
+	}
+
+	j.log.InfoContext(ctx, "extended charges ledger",
+		slog.Int("added", resp.Added),
+	)
+}
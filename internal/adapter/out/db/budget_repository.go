@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type BudgetRepository struct {
+	db *sqlx.DB
+}
+
+func NewBudgetRepo(db *sqlx.DB) *BudgetRepository {
+	return &BudgetRepository{
+		db: db,
+	}
+}
+
+func (r *BudgetRepository) Create(ctx context.Context, b *entity.Budget) (int, error) {
+	query := `
+		INSERT INTO budgets
+			(user_id, service_name, category, monthly_limit, currency)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	var id int
+	err := r.db.QueryRowContext(ctx, query, b.UserID, b.ServiceName, b.Category, b.MonthlyLimit, b.Currency).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create budget using db: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *BudgetRepository) Get(ctx context.Context, id int) (*entity.Budget, error) {
+	query := `
+		SELECT id, user_id, service_name, category, monthly_limit, currency
+		FROM budgets
+		WHERE id = $1
+	`
+
+	var b entity.Budget
+
+	err := r.db.GetContext(ctx, &b, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get budget using db: %w", err)
+	}
+
+	return &b, nil
+}
+
+func (r *BudgetRepository) Update(ctx context.Context, b *entity.Budget) error {
+	query := `
+		UPDATE budgets
+		SET
+			user_id       = $1,
+			service_name  = $2,
+			category      = $3,
+			monthly_limit = $4,
+			currency      = $5
+		WHERE id = $6
+	`
+
+	res, err := r.db.ExecContext(ctx, query, b.UserID, b.ServiceName, b.Category, b.MonthlyLimit, b.Currency, b.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update budget using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *BudgetRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM budgets WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete budget using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *BudgetRepository) GetList(ctx context.Context, f filter.BudgetFilter) ([]entity.Budget, error) {
+	var args []any
+
+	query := `
+		SELECT id, user_id, service_name, category, monthly_limit, currency
+		FROM budgets
+	`
+
+	if f.UserID != nil {
+		query += fmt.Sprintf(" WHERE user_id = $%d", len(args)+1)
+		args = append(args, *f.UserID)
+	}
+
+	query += " ORDER BY id"
+
+	if f.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+		args = append(args, f.Limit, f.Offset)
+	}
+
+	var budgets []entity.Budget
+	if err := r.db.SelectContext(ctx, &budgets, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get budgets using db: %w", err)
+	}
+
+	return budgets, nil
+}
@@ -0,0 +1,69 @@
+//go:build integration
+// +build integration
+
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/adapter/out/db"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_Budgets_CRUD(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewBudgetRepo(dbx)
+
+	uid := uuid.New()
+	netflix := "Netflix"
+
+	id, err := repo.Create(context.Background(), &entity.Budget{
+		UserID:       uid,
+		ServiceName:  &netflix,
+		MonthlyLimit: 1000,
+		Currency:     entity.DefaultCurrency,
+	})
+	require.NoError(t, err)
+	require.True(t, id > 0)
+
+	cloud := "cloud"
+	_, err = repo.Create(context.Background(), &entity.Budget{
+		UserID:       uuid.New(),
+		Category:     &cloud,
+		MonthlyLimit: 50,
+		Currency:     "USD",
+	})
+	require.NoError(t, err)
+
+	got, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, uid, got.UserID)
+	require.Equal(t, "Netflix", *got.ServiceName)
+	require.Equal(t, 1000, got.MonthlyLimit)
+
+	got.ServiceName = nil
+	got.MonthlyLimit = 2000
+	require.NoError(t, repo.Update(context.Background(), got))
+
+	list, err := repo.GetList(context.Background(), filter.BudgetFilter{UserID: &uid})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Nil(t, list[0].ServiceName)
+	require.Equal(t, 2000, list[0].MonthlyLimit)
+
+	all, err := repo.GetList(context.Background(), filter.BudgetFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "cloud", *all[1].Category)
+
+	require.NoError(t, repo.Delete(context.Background(), id))
+	require.ErrorIs(t, repo.Delete(context.Background(), id), sql.ErrNoRows)
+
+	_, err = repo.Get(context.Background(), id)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
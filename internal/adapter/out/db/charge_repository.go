@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// chargeRowsSQL selects one ledger row (subscription_id, charge_date, amount,
+// currency) per day s falls due between the date expressions from and to, at
+// the price in effect on that day.
+func chargeRowsSQL(from, to string) string {
+	return `
+		SELECT s.id, ref.day, p.price, s.currency
+		FROM subscriptions s
+		CROSS JOIN LATERAL (` + chargeDates(from, to) + `) AS ref
+		CROSS JOIN LATERAL (` + priceAtSQL + `) AS p`
+}
+
+type ChargeRepository struct {
+	db *sqlx.DB
+}
+
+func NewChargeRepo(db *sqlx.DB) *ChargeRepository {
+	return &ChargeRepository{
+		db: db,
+	}
+}
+
+// Sync replaces the recorded charges of the subscription from today on with
+// its current schedule up to until. Charges already due are billed and kept
+// as they are; earlier ones missing from the ledger, as for a backdated
+// subscription, are filled in.
+func (r *ChargeRepository) Sync(ctx context.Context, subscriptionID int, until time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM charges
+		WHERE subscription_id = $1 AND charge_date >= CURRENT_DATE
+	`, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to clear charges using db: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO charges
+			(subscription_id, charge_date, amount, currency)
+		`+chargeRowsSQL("s.start_date", "$2::date")+`
+		WHERE s.id = $1
+		ON CONFLICT (subscription_id, charge_date) DO NOTHING
+	`, subscriptionID, until)
+	if err != nil {
+		return fmt.Errorf("failed to record charges using db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit charges: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ChargeRepository) Extend(ctx context.Context, until time.Time) (int, error) {
+	from := `COALESCE(
+			(SELECT MAX(c.charge_date) + 1 FROM charges c WHERE c.subscription_id = s.id),
+			s.start_date
+		)`
+
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO charges
+			(subscription_id, charge_date, amount, currency)
+		`+chargeRowsSQL(from, "$1::date")+`
+		ON CONFLICT (subscription_id, charge_date) DO NOTHING
+	`, until)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extend charges using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count rows: %w", err)
+	}
+
+	return int(rows), nil
+}
+
+func (r *ChargeRepository) GetList(ctx context.Context, f filter.ChargeFilter) ([]entity.Charge, error) {
+	var where []string
+	var args []any
+
+	if f.UserID != nil {
+		where = append(where, fmt.Sprintf("s.user_id = $%d", len(args)+1))
+		args = append(args, *f.UserID)
+	}
+
+	if f.SubscriptionID != nil {
+		where = append(where, fmt.Sprintf("c.subscription_id = $%d", len(args)+1))
+		args = append(args, *f.SubscriptionID)
+	}
+
+	if f.StartDate != nil {
+		where = append(where, fmt.Sprintf("c.charge_date >= $%d", len(args)+1))
+		args = append(args, *f.StartDate)
+	}
+
+	if f.EndDate != nil {
+		where = append(where, fmt.Sprintf("c.charge_date <= $%d", len(args)+1))
+		args = append(args, *f.EndDate)
+	}
+
+	query := `
+		SELECT c.id, c.subscription_id, c.charge_date, c.amount, c.currency
+		FROM charges c
+		JOIN subscriptions s ON s.id = c.subscription_id
+	`
+
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	query += fmt.Sprintf(" ORDER BY c.charge_date, c.id LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, f.Limit, f.Offset)
+
+	var charges []entity.Charge
+	if err := r.db.SelectContext(ctx, &charges, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get charges using db: %w", err)
+	}
+
+	return charges, nil
+}
@@ -0,0 +1,85 @@
+//go:build integration
+// +build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/adapter/out/db"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_Charges_Sync_Extend(t *testing.T) {
+	dbx := setupDB(t)
+	subs := db.NewSubscriptionRepo(dbx)
+	charges := db.NewChargeRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	id, err := subs.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Netflix",
+		Price:         500,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(time.January, 15),
+	})
+	require.NoError(t, err)
+
+	err = subs.ChangePrice(context.Background(), entity.PricePeriod{
+		SubscriptionID: id,
+		Price:          800,
+		EffectiveFrom:  date(time.March, 1),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, charges.Sync(context.Background(), id, date(time.March, 31)))
+
+	list, err := charges.GetList(context.Background(), filter.ChargeFilter{UserID: &uid, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	require.True(t, list[0].Date.Equal(date(time.January, 15)))
+	require.Equal(t, entity.Money{Amount: 500, Currency: "RUB"}, list[1].Money)
+	require.Equal(t, entity.Money{Amount: 800, Currency: "RUB"}, list[2].Money)
+
+	// charges already due are kept as billed when the subscription changes
+	err = subs.ChangePrice(context.Background(), entity.PricePeriod{
+		SubscriptionID: id,
+		Price:          900,
+		EffectiveFrom:  date(time.February, 1),
+	})
+	require.NoError(t, err)
+	require.NoError(t, charges.Sync(context.Background(), id, date(time.March, 31)))
+
+	list, err = charges.GetList(context.Background(), filter.ChargeFilter{UserID: &uid, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	require.Equal(t, entity.Money{Amount: 500, Currency: "RUB"}, list[1].Money)
+
+	added, err := charges.Extend(context.Background(), date(time.May, 31))
+	require.NoError(t, err)
+	require.Equal(t, 2, added)
+
+	from := date(time.April, 1)
+	list, err = charges.GetList(context.Background(),
+		filter.ChargeFilter{SubscriptionID: &id, StartDate: &from, Limit: 10},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.True(t, list[1].Date.Equal(date(time.May, 15)))
+
+	// deleting the subscription drops its charges
+	require.NoError(t, subs.Delete(context.Background(), id))
+	list, err = charges.GetList(context.Background(), filter.ChargeFilter{UserID: &uid, Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
@@ -0,0 +1,198 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/maket12/SubTrack/internal/domain/filter"
+)
+
+// priceAtSQL picks the price of all seats in effect on the month's reference
+// day ref.day: the trial price while the trial lasts, then the latest price
+// period, times the seat count of the latest seat period. Months before the
+// earliest period fall back to that period.
+const priceAtSQL = `
+	SELECT CASE
+		WHEN s.trial_end_date IS NOT NULL AND ref.day <= s.trial_end_date THEN s.trial_price
+		ELSE COALESCE(
+			(
+				SELECT sp.price
+				FROM subscription_prices sp
+				WHERE sp.subscription_id = s.id
+				  AND sp.effective_from <= ref.day
+				ORDER BY sp.effective_from DESC
+				LIMIT 1
+			),
+			(
+				SELECT sp.price
+				FROM subscription_prices sp
+				WHERE sp.subscription_id = s.id
+				ORDER BY sp.effective_from
+				LIMIT 1
+			),
+			s.price
+		)
+	END * COALESCE(
+		(
+			SELECT ss.seats
+			FROM subscription_seats ss
+			WHERE ss.subscription_id = s.id
+			  AND ss.effective_from <= ref.day
+			ORDER BY ss.effective_from DESC
+			LIMIT 1
+		),
+		(
+			SELECT ss.seats
+			FROM subscription_seats ss
+			WHERE ss.subscription_id = s.id
+			ORDER BY ss.effective_from
+			LIMIT 1
+		),
+		s.seats
+	) AS price`
+
+// monthlyPriceSQL normalises p.price to one month of its billing period,
+// mirroring entity.BillingPeriod.MonthlyFactor.
+const monthlyPriceSQL = `
+	p.price * CASE s.billing_period
+		WHEN 'weekly'    THEN 52.0 / 12
+		WHEN 'quarterly' THEN 1.0 / 3
+		WHEN 'yearly'    THEN 1.0 / 12
+		ELSE 1
+	END`
+
+// pausedMonthSQL matches a pause covering the whole month m.month.
+const pausedMonthSQL = `
+	SELECT 1
+	FROM subscription_pauses sp
+	WHERE sp.subscription_id = s.id
+	  AND sp.paused_at <= m.month
+	  AND (sp.resumed_at IS NULL OR sp.resumed_at >= m.month + interval '1 month')`
+
+// chargeDates builds a subquery yielding the days (day) between the SQL date
+// expressions from and to on which s falls due: its start date and every
+// billing period after it, until its end date and outside of pauses. Only
+// approved subscriptions fall due. Periods are added to the start date in one
+// step, so a subscription started on the 31st falls due on the last day of
+// shorter months and on the 31st again after them. The range of periods
+// scanned is bounded by the shortest and longest length of one period.
+func chargeDates(from, to string) string {
+	return fmt.Sprintf(`
+		SELECT d.day
+		FROM generate_series(
+			GREATEST(0, FLOOR((%[1]s - s.start_date)::numeric / %[3]s))::int,
+			CEIL((%[2]s - s.start_date)::numeric / %[4]s)::int
+		) AS n
+		CROSS JOIN LATERAL (SELECT (s.start_date + n * %[5]s)::date AS day) AS d
+		WHERE d.day BETWEEN %[1]s AND %[2]s
+		  AND s.approval = 'approved'
+		  AND (s.end_date IS NULL OR d.day <= s.end_date)
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM subscription_pauses sp
+			WHERE sp.subscription_id = s.id
+			  AND sp.paused_at <= d.day
+			  AND (sp.resumed_at IS NULL OR sp.resumed_at > d.day)
+		  )`,
+		from, to, longestPeriodSQL, shortestPeriodSQL, billingStepSQL)
+}
+
+// billingStepSQL is one billing period of s.
+const billingStepSQL = `CASE s.billing_period
+			WHEN 'weekly'    THEN interval '7 days'
+			WHEN 'quarterly' THEN interval '3 months'
+			WHEN 'yearly'    THEN interval '1 year'
+			ELSE interval '1 month'
+		END`
+
+// longestPeriodSQL and shortestPeriodSQL bound the length in days of one
+// billing period of s.
+const (
+	longestPeriodSQL = `CASE s.billing_period
+			WHEN 'weekly' THEN 7 WHEN 'quarterly' THEN 92 WHEN 'yearly' THEN 366 ELSE 31
+		END`
+	shortestPeriodSQL = `CASE s.billing_period
+			WHEN 'weekly' THEN 7 WHEN 'quarterly' THEN 89 WHEN 'yearly' THEN 365 ELSE 28
+		END`
+)
+
+// monthlyCharges builds a query with one row (month, subscription_id,
+// service_name, user_id, committed, currency, amount) per approved
+// subscription, payer and calendar month in which it is active inside the
+// filter window. A shared subscription is split between its owner and
+// members as in sharesSQL, and the user filter keeps the user's own share.
+// Every such month is charged the monthly-normalised price in effect on its
+// reference day: the first of the month, or the start date in the
+// subscription's first month. Months spent paused from start to finish are
+// not charged. Open-ended subscriptions run until the end of the window, or
+// until the current month when no end is given. Charges of subscriptions with
+// an end date are committed.
+// One-off charges come as extra committed rows in the month of their day, whole
+// to the user they are charged to. Those of a subscription count once it is
+// approved; those without one have an empty service name and are left out by
+// the label filters.
+func monthlyCharges(f filter.SumFilter) (string, []any) {
+	args := []any{f.StartDate, f.EndDate}
+	where := []string{
+		"($1::date IS NULL OR s.end_date IS NULL OR s.end_date >= $1::date)",
+		"($2::date IS NULL OR s.start_date <= $2::date)",
+		"NOT EXISTS (" + pausedMonthSQL + ")",
+		"s.approval = 'approved'",
+	}
+	oneOff := []string{
+		"($1::date IS NULL OR o.charged_on >= date_trunc('month', $1::date))",
+		"($2::date IS NULL OR o.charged_on < date_trunc('month', $2::date) + interval '1 month')",
+		"(o.subscription_id IS NULL OR s.approval = 'approved')",
+	}
+
+	if f.UserID != nil {
+		where = append(where, fmt.Sprintf("sh.user_id = $%d", len(args)+1))
+		oneOff = append(oneOff, fmt.Sprintf("o.user_id = $%d", len(args)+1))
+		args = append(args, *f.UserID)
+	}
+
+	// the label filters match the subscription s of either kind of charge
+	var labels []string
+	if f.ServiceName != nil {
+		labels = append(labels, serviceNameMatchSQL(len(args)+1))
+		args = append(args, *f.ServiceName)
+	}
+
+	if f.Category != nil {
+		labels = append(labels, categoryMatchSQL(len(args)+1))
+		args = append(args, *f.Category)
+	}
+
+	if f.Tag != nil {
+		labels = append(labels, tagMatchSQL(len(args)+1))
+		args = append(args, *f.Tag)
+	}
+	where = append(where, labels...)
+	oneOff = append(oneOff, labels...)
+
+	query := `
+		SELECT m.month::date AS month, s.id AS subscription_id, s.service_name, sh.user_id,
+			s.end_date IS NOT NULL AS committed, s.currency, sh.amount
+		FROM subscriptions s
+		CROSS JOIN LATERAL generate_series(
+			date_trunc('month', GREATEST(s.start_date, COALESCE($1::date, s.start_date))),
+			date_trunc('month', LEAST(
+				COALESCE(s.end_date, $2::date, CURRENT_DATE),
+				COALESCE($2::date, 'infinity'::date)
+			)),
+			interval '1 month'
+		) AS m(month)
+		CROSS JOIN LATERAL (SELECT GREATEST(m.month::date, s.start_date) AS day) AS ref
+		CROSS JOIN LATERAL (` + priceAtSQL + `) AS p
+		CROSS JOIN LATERAL (SELECT ` + monthlyPriceSQL + ` AS amount) AS a
+		CROSS JOIN LATERAL (` + sharesSQL + `) AS sh
+		WHERE ` + strings.Join(where, " AND ") + `
+		UNION ALL
+		SELECT date_trunc('month', o.charged_on)::date AS month, o.subscription_id,
+			COALESCE(s.service_name, '') AS service_name, o.user_id, true AS committed, o.currency, o.amount
+		FROM one_off_charges o
+		LEFT JOIN subscriptions s ON s.id = o.subscription_id
+		WHERE ` + strings.Join(oneOff, " AND ")
+
+	return query, args
+}
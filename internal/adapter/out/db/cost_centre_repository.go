@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type CostCentreRepository struct {
+	db *sqlx.DB
+}
+
+func NewCostCentreRepo(db *sqlx.DB) *CostCentreRepository {
+	return &CostCentreRepository{
+		db: db,
+	}
+}
+
+func (r *CostCentreRepository) Create(ctx context.Context, c *entity.CostCentre) (int, error) {
+	query := `
+		INSERT INTO cost_centres
+			(name, code)
+		VALUES
+			($1, $2)
+		RETURNING id
+	`
+
+	var id int
+	if err := r.db.QueryRowContext(ctx, query, c.Name, c.Code).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to create cost centre using db: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *CostCentreRepository) Get(ctx context.Context, id int) (*entity.CostCentre, error) {
+	query := `
+		SELECT id, name, code
+		FROM cost_centres
+		WHERE id = $1
+	`
+
+	var c entity.CostCentre
+
+	err := r.db.GetContext(ctx, &c, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get cost centre using db: %w", err)
+	}
+
+	return &c, nil
+}
+
+func (r *CostCentreRepository) FindByName(ctx context.Context, name string) (*entity.CostCentre, error) {
+	query := `
+		SELECT id, name, code
+		FROM cost_centres
+		WHERE lower(name) = lower($1)
+	`
+
+	var c entity.CostCentre
+
+	err := r.db.GetContext(ctx, &c, query, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to find cost centre using db: %w", err)
+	}
+
+	return &c, nil
+}
+
+func (r *CostCentreRepository) Update(ctx context.Context, c *entity.CostCentre) error {
+	query := `
+		UPDATE cost_centres
+		SET
+			name = $1,
+			code = $2
+		WHERE id = $3
+	`
+
+	res, err := r.db.ExecContext(ctx, query, c.Name, c.Code, c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update cost centre using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Delete removes the cost centre together with its allocations, whose share
+// of spend becomes unallocated.
+func (r *CostCentreRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM cost_centres WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete cost centre using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *CostCentreRepository) GetList(ctx context.Context, f filter.CostCentreFilter) ([]entity.CostCentre, error) {
+	query := `
+		SELECT id, name, code
+		FROM cost_centres
+		ORDER BY lower(name)
+		LIMIT $1 OFFSET $2
+	`
+
+	var centres []entity.CostCentre
+	if err := r.db.SelectContext(ctx, &centres, query, f.Limit, f.Offset); err != nil {
+		return nil, fmt.Errorf("failed to get cost centres using db: %w", err)
+	}
+
+	return centres, nil
+}
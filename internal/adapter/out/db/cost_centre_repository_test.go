@@ -0,0 +1,56 @@
+//go:build integration
+// +build integration
+
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/adapter/out/db"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_CostCentres_CRUD(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewCostCentreRepo(dbx)
+
+	code := "CC-100"
+	id, err := repo.Create(context.Background(), &entity.CostCentre{Name: "Marketing", Code: &code})
+	require.NoError(t, err)
+	require.True(t, id > 0)
+
+	got, err := repo.FindByName(context.Background(), "MARKETING")
+	require.NoError(t, err)
+	require.Equal(t, id, got.ID)
+	require.Equal(t, "CC-100", *got.Code)
+
+	_, err = repo.FindByName(context.Background(), "Sales")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	got.Name = "Growth"
+	got.Code = nil
+	require.NoError(t, repo.Update(context.Background(), got))
+
+	got, err = repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, "Growth", got.Name)
+	require.Nil(t, got.Code)
+
+	_, err = repo.Create(context.Background(), &entity.CostCentre{Name: "engineering"})
+	require.NoError(t, err)
+
+	all, err := repo.GetList(context.Background(), filter.CostCentreFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "engineering", all[0].Name)
+
+	require.NoError(t, repo.Delete(context.Background(), id))
+	require.ErrorIs(t, repo.Delete(context.Background(), id), sql.ErrNoRows)
+
+	_, err = repo.Get(context.Background(), id)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
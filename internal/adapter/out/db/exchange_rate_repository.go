@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ExchangeRateRepository struct {
+	db *sqlx.DB
+}
+
+func NewExchangeRateRepo(db *sqlx.DB) *ExchangeRateRepository {
+	return &ExchangeRateRepository{
+		db: db,
+	}
+}
+
+// Save upserts all rates in one transaction, so a partially broken batch
+// leaves the table untouched.
+func (r *ExchangeRateRepository) Save(ctx context.Context, rates []entity.ExchangeRate) error {
+	query := `
+		INSERT INTO exchange_rates
+			(rate_date, base, quote, rate)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (base, quote, rate_date) DO UPDATE
+		SET rate = EXCLUDED.rate
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, rate := range rates {
+		if _, err := tx.ExecContext(ctx, query, rate.Date, rate.Base, rate.Quote, rate.Rate); err != nil {
+			return fmt.Errorf("failed to save exchange rate using db: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit exchange rates: %w", err)
+	}
+
+	return nil
+}
+
+// GetRate returns the latest known rate on or before the given date. A stored
+// rate for the opposite direction is inverted when no direct rate exists.
+func (r *ExchangeRateRepository) GetRate(ctx context.Context, from, to entity.Currency, on time.Time) (float64, error) {
+	query := `
+		SELECT rate
+		FROM (
+			SELECT rate, rate_date
+			FROM exchange_rates
+			WHERE base = $1 AND quote = $2 AND rate_date <= $3
+			UNION ALL
+			SELECT 1 / rate, rate_date
+			FROM exchange_rates
+			WHERE base = $2 AND quote = $1 AND rate_date <= $3
+		) r
+		ORDER BY rate_date DESC
+		LIMIT 1
+	`
+
+	var rate float64
+	err := r.db.GetContext(ctx, &rate, query, from, to, on)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("failed to get exchange rate using db: %w", err)
+	}
+
+	return rate, nil
+}
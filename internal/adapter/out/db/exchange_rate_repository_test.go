@@ -0,0 +1,51 @@
+//go:build integration
+// +build integration
+
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maket12/SubTrack/internal/adapter/out/db"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+)
+
+func TestPostgres_ExchangeRates(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewExchangeRateRepo(dbx)
+
+	jan := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	err := repo.Save(context.Background(), []entity.ExchangeRate{
+		{Date: jan, Base: "EUR", Quote: "RUB", Rate: 100},
+		{Date: feb, Base: "EUR", Quote: "RUB", Rate: 80},
+	})
+	require.NoError(t, err)
+
+	// re-importing a day overwrites its rate
+	err = repo.Save(context.Background(), []entity.ExchangeRate{
+		{Date: feb, Base: "EUR", Quote: "RUB", Rate: 90},
+	})
+	require.NoError(t, err)
+
+	rate, err := repo.GetRate(context.Background(), "EUR", "RUB", jan.AddDate(0, 0, 30))
+	require.NoError(t, err)
+	require.InDelta(t, 100, rate, 1e-9)
+
+	rate, err = repo.GetRate(context.Background(), "EUR", "RUB", feb.AddDate(0, 0, 27))
+	require.NoError(t, err)
+	require.InDelta(t, 90, rate, 1e-9)
+
+	rate, err = repo.GetRate(context.Background(), "RUB", "EUR", feb)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0/90, rate, 1e-9)
+
+	_, err = repo.GetRate(context.Background(), "EUR", "RUB", jan.AddDate(0, 0, -1))
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
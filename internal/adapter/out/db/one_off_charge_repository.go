@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// oneOffChargeColumns selects one-off charges o with the service name of their
+// subscription s, if any.
+const oneOffChargeColumns = `
+		o.id, o.subscription_id, s.service_name, o.user_id, o.charged_on,
+		o.description, o.termination_fee, o.amount, o.currency`
+
+type OneOffChargeRepository struct {
+	db *sqlx.DB
+}
+
+func NewOneOffChargeRepo(db *sqlx.DB) *OneOffChargeRepository {
+	return &OneOffChargeRepository{
+		db: db,
+	}
+}
+
+func (r *OneOffChargeRepository) Create(ctx context.Context, c *entity.OneOffCharge) (int, error) {
+	query := `
+		INSERT INTO one_off_charges
+			(subscription_id, user_id, charged_on, amount, currency, description)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id int
+	err := r.db.QueryRowContext(ctx, query,
+		c.SubscriptionID,
+		c.UserID,
+		c.Date,
+		c.Amount,
+		c.Currency,
+		c.Description,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create one-off charge using db: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *OneOffChargeRepository) Get(ctx context.Context, id int) (*entity.OneOffCharge, error) {
+	query := `
+		SELECT` + oneOffChargeColumns + `
+		FROM one_off_charges o
+		LEFT JOIN subscriptions s ON s.id = o.subscription_id
+		WHERE o.id = $1
+	`
+
+	var c entity.OneOffCharge
+
+	err := r.db.GetContext(ctx, &c, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get one-off charge using db: %w", err)
+	}
+
+	return &c, nil
+}
+
+func (r *OneOffChargeRepository) Update(ctx context.Context, c *entity.OneOffCharge) error {
+	query := `
+		UPDATE one_off_charges
+		SET
+			subscription_id = $1,
+			user_id         = $2,
+			charged_on      = $3,
+			amount          = $4,
+			currency        = $5,
+			description     = $6
+		WHERE id = $7 AND NOT termination_fee
+	`
+
+	res, err := r.db.ExecContext(ctx, query,
+		c.SubscriptionID,
+		c.UserID,
+		c.Date,
+		c.Amount,
+		c.Currency,
+		c.Description,
+		c.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update one-off charge using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *OneOffChargeRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM one_off_charges WHERE id = $1 AND NOT termination_fee`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete one-off charge using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *OneOffChargeRepository) GetList(ctx context.Context, f filter.OneOffChargeFilter) ([]entity.OneOffCharge, error) {
+	var where []string
+	var args []any
+
+	if f.UserID != nil {
+		where = append(where, fmt.Sprintf("o.user_id = $%d", len(args)+1))
+		args = append(args, *f.UserID)
+	}
+
+	if f.SubscriptionID != nil {
+		where = append(where, fmt.Sprintf("o.subscription_id = $%d", len(args)+1))
+		args = append(args, *f.SubscriptionID)
+	}
+
+	if f.StartDate != nil {
+		where = append(where, fmt.Sprintf("o.charged_on >= $%d", len(args)+1))
+		args = append(args, *f.StartDate)
+	}
+
+	if f.EndDate != nil {
+		where = append(where, fmt.Sprintf("o.charged_on <= $%d", len(args)+1))
+		args = append(args, *f.EndDate)
+	}
+
+	query := `
+		SELECT` + oneOffChargeColumns + `
+		FROM one_off_charges o
+		LEFT JOIN subscriptions s ON s.id = o.subscription_id
+	`
+
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	query += fmt.Sprintf(" ORDER BY o.charged_on, o.id LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, f.Limit, f.Offset)
+
+	var charges []entity.OneOffCharge
+	if err := r.db.SelectContext(ctx, &charges, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get one-off charges using db: %w", err)
+	}
+
+	return charges, nil
+}
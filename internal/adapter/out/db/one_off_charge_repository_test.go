@@ -0,0 +1,212 @@
+//go:build integration
+// +build integration
+
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/adapter/out/db"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_OneOffCharges_CRUD(t *testing.T) {
+	dbx := setupDB(t)
+	subs := db.NewSubscriptionRepo(dbx)
+	repo := db.NewOneOffChargeRepo(dbx)
+
+	uid := uuid.New()
+	subID, err := subs.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Jira",
+		Price:         1000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	id, err := repo.Create(context.Background(), &entity.OneOffCharge{
+		SubscriptionID: &subID,
+		UserID:         uid,
+		Date:           time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC),
+		Description:    "Setup fee",
+		Money:          entity.Money{Amount: 2000, Currency: entity.DefaultCurrency},
+	})
+	require.NoError(t, err)
+	require.True(t, id > 0)
+
+	got, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, subID, *got.SubscriptionID)
+	require.Equal(t, "Jira", *got.ServiceName)
+	require.Equal(t, 2000, got.Amount)
+
+	// without a subscription the charge is the user's alone
+	standalone, err := repo.Create(context.Background(), &entity.OneOffCharge{
+		UserID:      uid,
+		Date:        time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+		Description: "Conference ticket",
+		Money:       entity.Money{Amount: 500, Currency: entity.DefaultCurrency},
+	})
+	require.NoError(t, err)
+
+	got, err = repo.Get(context.Background(), standalone)
+	require.NoError(t, err)
+	require.Nil(t, got.SubscriptionID)
+	require.Nil(t, got.ServiceName)
+
+	got.Amount = 700
+	require.NoError(t, repo.Update(context.Background(), got))
+
+	all, err := repo.GetList(context.Background(), filter.OneOffChargeFilter{UserID: &uid, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, id, all[0].ID)
+	require.Equal(t, 700, all[1].Amount)
+
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	all, err = repo.GetList(context.Background(), filter.OneOffChargeFilter{StartDate: &from, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, standalone, all[0].ID)
+
+	require.NoError(t, repo.Delete(context.Background(), standalone))
+	require.ErrorIs(t, repo.Delete(context.Background(), standalone), sql.ErrNoRows)
+
+	_, err = repo.Get(context.Background(), standalone)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// charges of a subscription go with it
+	require.NoError(t, subs.Delete(context.Background(), subID))
+
+	_, err = repo.Get(context.Background(), id)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestPostgres_OneOffCharges_TerminationFeeUntouched(t *testing.T) {
+	dbx := setupDB(t)
+	subs := db.NewSubscriptionRepo(dbx)
+	repo := db.NewOneOffChargeRepo(dbx)
+
+	uid := uuid.New()
+	end := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+	sub := &entity.Subscription{
+		ServiceName:   "Jira",
+		Price:         1000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:       &end,
+	}
+	id, err := subs.Create(context.Background(), sub)
+	require.NoError(t, err)
+	sub.ID = id
+
+	fee := &entity.OneOffCharge{
+		UserID:      uid,
+		Date:        end,
+		Description: "Early termination fee: Jira",
+		Money:       entity.Money{Amount: 9000, Currency: entity.DefaultCurrency},
+	}
+	sub.CancelledAt = &end
+	require.NoError(t, subs.Cancel(context.Background(), sub, fee))
+
+	// the fee is neither edited, unlinked nor deleted as a plain charge
+	changed := *fee
+	changed.SubscriptionID = nil
+	changed.Amount = 0
+	require.ErrorIs(t, repo.Update(context.Background(), &changed), sql.ErrNoRows)
+	require.ErrorIs(t, repo.Delete(context.Background(), fee.ID), sql.ErrNoRows)
+
+	got, err := repo.Get(context.Background(), fee.ID)
+	require.NoError(t, err)
+	require.True(t, got.TerminationFee)
+	require.Equal(t, id, *got.SubscriptionID)
+	require.Equal(t, 9000, got.Amount)
+}
+
+func TestPostgres_OneOffCharges_Totals(t *testing.T) {
+	dbx := setupDB(t)
+	subs := db.NewSubscriptionRepo(dbx)
+	repo := db.NewOneOffChargeRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	end := date(time.March, 31)
+	subID, err := subs.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Jira",
+		Price:         1000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(time.January, 1),
+		EndDate:       &end,
+	})
+	require.NoError(t, err)
+
+	_, err = repo.Create(context.Background(), &entity.OneOffCharge{
+		SubscriptionID: &subID,
+		UserID:         uid,
+		Date:           date(time.January, 10),
+		Description:    "Setup fee",
+		Money:          entity.Money{Amount: 2000, Currency: entity.DefaultCurrency},
+	})
+	require.NoError(t, err)
+	_, err = repo.Create(context.Background(), &entity.OneOffCharge{
+		UserID:      uid,
+		Date:        date(time.February, 20),
+		Description: "Conference ticket",
+		Money:       entity.Money{Amount: 500, Currency: entity.DefaultCurrency},
+	})
+	require.NoError(t, err)
+	// outside the window
+	_, err = repo.Create(context.Background(), &entity.OneOffCharge{
+		UserID:      uid,
+		Date:        date(time.June, 1),
+		Description: "Overage",
+		Money:       entity.Money{Amount: 9000, Currency: entity.DefaultCurrency},
+	})
+	require.NoError(t, err)
+
+	from, to := date(time.January, 1), date(time.March, 1)
+	f := filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to}
+
+	totals, err := subs.GetTotalSum(context.Background(), f)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 3*1000 + 2000 + 500, Currency: "RUB"}}, totals)
+
+	sums, err := subs.GetMonthlySums(context.Background(), f)
+	require.NoError(t, err)
+	require.Len(t, sums, 3)
+	require.Equal(t, 1000+2000, sums[0].Amount)
+	require.Equal(t, 1000+500, sums[1].Amount)
+	require.Equal(t, 1000, sums[2].Amount)
+
+	// a charge without a subscription has no service
+	f.GroupBy = []filter.SumGroup{filter.SumGroupServiceName}
+	groups, err := subs.GetGroupedSums(context.Background(), f)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	require.Equal(t, "", *groups[0].ServiceName)
+	require.Equal(t, 500, groups[0].Amount)
+	require.Equal(t, 3*1000+2000, groups[1].Amount)
+
+	// and no service or label filter matches it
+	jira := "Jira"
+	totals, err = subs.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to, ServiceName: &jira},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 3*1000 + 2000, Currency: "RUB"}}, totals)
+}
@@ -0,0 +1,218 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// serviceColumns selects a catalog entry from services sv with its aliases in
+// alphabetical order.
+const serviceColumns = `sv.id, sv.name,
+	ARRAY(
+		SELECT sa.alias FROM service_aliases sa WHERE sa.service_id = sv.id ORDER BY sa.alias
+	) AS aliases`
+
+// serviceNameMatchSQL matches subscriptions s filed under the name given by
+// the query parameter $n, or under the catalog entry known by that name or one
+// of its aliases in any case.
+func serviceNameMatchSQL(n int) string {
+	return fmt.Sprintf(`(s.service_name = $%[1]d OR s.service_id IN (
+		SELECT sv.id FROM services sv WHERE lower(sv.name) = lower($%[1]d)
+		UNION
+		SELECT sa.service_id FROM service_aliases sa WHERE lower(sa.alias) = lower($%[1]d)
+	))`, n)
+}
+
+type serviceRow struct {
+	ID      int            `db:"id"`
+	Name    string         `db:"name"`
+	Aliases pq.StringArray `db:"aliases"`
+}
+
+func (r serviceRow) entity() entity.Service {
+	return entity.Service{ID: r.ID, Name: r.Name, Aliases: []string(r.Aliases)}
+}
+
+type ServiceRepository struct {
+	db *sqlx.DB
+}
+
+func NewServiceRepo(db *sqlx.DB) *ServiceRepository {
+	return &ServiceRepository{
+		db: db,
+	}
+}
+
+// Create inserts the service together with its aliases.
+func (r *ServiceRepository) Create(ctx context.Context, s *entity.Service) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+	err = tx.QueryRowContext(ctx, `INSERT INTO services (name) VALUES ($1) RETURNING id`, s.Name).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create service using db: %w", err)
+	}
+
+	if err := insertAliases(ctx, tx, id, s.Aliases); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit service: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *ServiceRepository) Get(ctx context.Context, id int) (*entity.Service, error) {
+	query := `
+		SELECT ` + serviceColumns + `
+		FROM services sv
+		WHERE sv.id = $1
+	`
+
+	var row serviceRow
+
+	err := r.db.GetContext(ctx, &row, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get service using db: %w", err)
+	}
+
+	s := row.entity()
+	return &s, nil
+}
+
+func (r *ServiceRepository) Resolve(ctx context.Context, name string) (*entity.Service, error) {
+	query := `
+		SELECT ` + serviceColumns + `
+		FROM services sv
+		WHERE lower(sv.name) = lower($1)
+		   OR sv.id IN (SELECT sa.service_id FROM service_aliases sa WHERE lower(sa.alias) = lower($1))
+		LIMIT 1
+	`
+
+	var row serviceRow
+
+	err := r.db.GetContext(ctx, &row, query, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to resolve service using db: %w", err)
+	}
+
+	s := row.entity()
+	return &s, nil
+}
+
+func (r *ServiceRepository) Update(ctx context.Context, s *entity.Service) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE services SET name = $1 WHERE id = $2`, s.Name, s.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update service using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM service_aliases WHERE service_id = $1`, s.ID)
+	if err != nil {
+		return fmt.Errorf("failed to clear service aliases using db: %w", err)
+	}
+
+	if err := insertAliases(ctx, tx, s.ID, s.Aliases); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE subscriptions SET service_name = $1 WHERE service_id = $2`, s.Name, s.ID)
+	if err != nil {
+		return fmt.Errorf("failed to rename subscriptions using db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit service: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the service from the catalog; its subscriptions keep their
+// name but no longer refer to it.
+func (r *ServiceRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM services WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete service using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *ServiceRepository) GetList(ctx context.Context, f filter.ServiceFilter) ([]entity.Service, error) {
+	query := `
+		SELECT ` + serviceColumns + `
+		FROM services sv
+		ORDER BY lower(sv.name)
+		LIMIT $1 OFFSET $2
+	`
+
+	var rows []serviceRow
+	if err := r.db.SelectContext(ctx, &rows, query, f.Limit, f.Offset); err != nil {
+		return nil, fmt.Errorf("failed to get services using db: %w", err)
+	}
+
+	services := make([]entity.Service, 0, len(rows))
+	for _, row := range rows {
+		services = append(services, row.entity())
+	}
+
+	return services, nil
+}
+
+func insertAliases(ctx context.Context, tx *sqlx.Tx, serviceID int, aliases []string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO service_aliases (service_id, alias)
+		SELECT $1, unnest($2::text[])
+	`, serviceID, pq.Array(aliases))
+	if err != nil {
+		return fmt.Errorf("failed to add service aliases using db: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+//go:build integration
+// +build integration
+
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/adapter/out/db"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_Services_CRUD(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewServiceRepo(dbx)
+	subs := db.NewSubscriptionRepo(dbx)
+
+	id, err := repo.Create(context.Background(), &entity.Service{
+		Name:    "YouTube Premium",
+		Aliases: []string{"YT Premium", "YouTube"},
+	})
+	require.NoError(t, err)
+	require.True(t, id > 0)
+
+	got, err := repo.Resolve(context.Background(), "yt premium")
+	require.NoError(t, err)
+	require.Equal(t, id, got.ID)
+	require.Equal(t, "YouTube Premium", got.Name)
+	require.Equal(t, []string{"YT Premium", "YouTube"}, got.Aliases)
+
+	got, err = repo.Resolve(context.Background(), "YOUTUBE PREMIUM")
+	require.NoError(t, err)
+	require.Equal(t, id, got.ID)
+
+	_, err = repo.Resolve(context.Background(), "Netflix")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	uid := uuid.New()
+	_, err = subs.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "YouTube Premium",
+		ServiceID:     &id,
+		Price:         300,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	// filtering by an alias finds subscriptions filed under the canonical name
+	alias := "youtube"
+	list, err := subs.GetList(context.Background(), filter.ListFilter{
+		ServiceName: &alias,
+		Limit:       10,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	got.Name = "YouTube"
+	got.Aliases = []string{"YT"}
+	require.NoError(t, repo.Update(context.Background(), got))
+
+	got, err = repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, "YouTube", got.Name)
+	require.Equal(t, []string{"YT"}, got.Aliases)
+
+	list, err = subs.GetList(context.Background(), filter.ListFilter{UserID: &uid, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "YouTube", list[0].ServiceName)
+
+	all, err := repo.GetList(context.Background(), filter.ServiceFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	require.NoError(t, repo.Delete(context.Background(), id))
+	require.ErrorIs(t, repo.Delete(context.Background(), id), sql.ErrNoRows)
+
+	list, err = subs.GetList(context.Background(), filter.ListFilter{UserID: &uid, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Nil(t, list[0].ServiceID)
+	require.Equal(t, "YouTube", list[0].ServiceName)
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// allocationsSQL splits the charges of subscription c.subscription_id into one
+// row (cost_centre_id, percent) per cost centre it is allocated to, and one
+// without a cost centre for the percentage left unallocated, if any.
+const allocationsSQL = `
+	SELECT a.cost_centre_id, a.percent
+	FROM subscription_allocations a
+	WHERE a.subscription_id = c.subscription_id
+	UNION ALL
+	SELECT NULL, 100 - COALESCE(SUM(a.percent), 0)
+	FROM subscription_allocations a
+	WHERE a.subscription_id = c.subscription_id
+	HAVING COALESCE(SUM(a.percent), 0) < 100`
+
+// attachAllocations loads the cost centre allocations of the subscriptions.
+func attachAllocations(ctx context.Context, db *sqlx.DB, subs []entity.Subscription) error {
+	if len(subs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(subs))
+	for _, s := range subs {
+		ids = append(ids, int64(s.ID))
+	}
+
+	var rows []entity.Allocation
+	err := db.SelectContext(ctx, &rows, `
+		SELECT subscription_id, cost_centre_id, percent::float8 AS percent
+		FROM subscription_allocations
+		WHERE subscription_id = ANY($1::int[])
+		ORDER BY subscription_id, cost_centre_id
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to get subscription allocations using db: %w", err)
+	}
+
+	allocations := make(map[int][]entity.Allocation, len(subs))
+	for _, row := range rows {
+		allocations[row.SubscriptionID] = append(allocations[row.SubscriptionID], row)
+	}
+	for i := range subs {
+		subs[i].Allocations = allocations[subs[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// categoryColumn selects the category name of subscriptions s.
+const categoryColumn = `(
+		SELECT cat.name FROM categories cat WHERE cat.id = s.category_id
+	) AS category`
+
+// categoryMatchSQL matches subscriptions s whose category is named by the
+// query parameter $n in any case.
+func categoryMatchSQL(n int) string {
+	return fmt.Sprintf(`s.category_id IN (
+		SELECT cat.id FROM categories cat WHERE lower(cat.name) = lower($%d)
+	)`, n)
+}
+
+// tagMatchSQL matches subscriptions s carrying the tag given by the query
+// parameter $n in any case.
+func tagMatchSQL(n int) string {
+	return fmt.Sprintf(`EXISTS (
+		SELECT 1 FROM subscription_tags st WHERE st.subscription_id = s.id AND lower(st.tag) = lower($%d)
+	)`, n)
+}
+
+// ensureCategory returns the id of the category with the name in any case,
+// adding it when there is none yet. A nil name means no category.
+func ensureCategory(ctx context.Context, tx *sqlx.Tx, name *string) (*int, error) {
+	if name == nil {
+		return nil, nil
+	}
+
+	var id int
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO categories (name) VALUES ($1)
+		ON CONFLICT (lower(name)) DO UPDATE SET name = categories.name
+		RETURNING id
+	`, *name).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add category using db: %w", err)
+	}
+
+	return &id, nil
+}
+
+// replaceTags sets the tags of the subscription to exactly the given ones.
+func replaceTags(ctx context.Context, tx *sqlx.Tx, subscriptionID int, tags []string) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM subscription_tags WHERE subscription_id = $1`, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to clear subscription tags using db: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO subscription_tags (subscription_id, tag)
+		SELECT $1, unnest($2::text[])
+	`, subscriptionID, pq.Array(tags))
+	if err != nil {
+		return fmt.Errorf("failed to add subscription tags using db: %w", err)
+	}
+
+	return nil
+}
+
+// attachTags loads the tags of the subscriptions, in alphabetical order.
+func attachTags(ctx context.Context, db *sqlx.DB, subs []entity.Subscription) error {
+	if len(subs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(subs))
+	for _, s := range subs {
+		ids = append(ids, int64(s.ID))
+	}
+
+	var rows []struct {
+		SubscriptionID int    `db:"subscription_id"`
+		Tag            string `db:"tag"`
+	}
+	err := db.SelectContext(ctx, &rows, `
+		SELECT subscription_id, tag
+		FROM subscription_tags
+		WHERE subscription_id = ANY($1::int[])
+		ORDER BY subscription_id, lower(tag)
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to get subscription tags using db: %w", err)
+	}
+
+	tags := make(map[int][]string, len(subs))
+	for _, row := range rows {
+		tags[row.SubscriptionID] = append(tags[row.SubscriptionID], row.Tag)
+	}
+	for i := range subs {
+		subs[i].Tags = tags[subs[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// memberSharesSQL selects one row (user_id, share) per member of subscription
+// s: their percentage of the monthly amount a.amount or their fixed amount.
+const memberSharesSQL = `
+	SELECT sm.user_id, COALESCE(a.amount * sm.share_percent / 100, sm.share_amount) AS share
+	FROM subscription_members sm
+	WHERE sm.subscription_id = s.id`
+
+// sharesSQL splits the monthly amount a.amount of subscription s into one row
+// (user_id, amount) per payer. Members pay their share; the owner pays what
+// is left, if anything. Shares adding up to more than the whole, as after a
+// price cut, are scaled down so that the members pay exactly the whole.
+const sharesSQL = `
+	SELECT m.user_id,
+		m.share * LEAST(1, a.amount / NULLIF(SUM(m.share) OVER (), 0)) AS amount
+	FROM (` + memberSharesSQL + `) AS m
+	UNION ALL
+	SELECT s.user_id, GREATEST(0, a.amount - COALESCE((
+		SELECT SUM(m.share) FROM (` + memberSharesSQL + `) AS m
+	), 0)) AS amount`
+
+// attachMembers loads the members of the subscriptions.
+func attachMembers(ctx context.Context, db *sqlx.DB, subs []entity.Subscription) error {
+	if len(subs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(subs))
+	for _, s := range subs {
+		ids = append(ids, int64(s.ID))
+	}
+
+	var rows []entity.Member
+	err := db.SelectContext(ctx, &rows, `
+		SELECT subscription_id, user_id, share_percent::float8 AS share_percent, share_amount
+		FROM subscription_members
+		WHERE subscription_id = ANY($1::int[])
+		ORDER BY subscription_id, user_id
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to get subscription members using db: %w", err)
+	}
+
+	members := make(map[int][]entity.Member, len(subs))
+	for _, row := range rows {
+		members[row.SubscriptionID] = append(members[row.SubscriptionID], row)
+	}
+	for i := range subs {
+		subs[i].Members = members[subs[i].ID]
+	}
+
+	return nil
+}
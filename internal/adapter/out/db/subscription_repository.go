@@ -6,13 +6,71 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/maket12/SubTrack/internal/domain/entity"
 	"github.com/maket12/SubTrack/internal/domain/filter"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
+// subscriptionColumns selects a subscription row from subscriptions s together
+// with the start of its pause in progress, its current status (see
+// entity.SubscriptionStatus) and its next charge date, if approved. Price and
+// seats are those in effect today. Every charge date lies within a year from
+// today, unless there is none.
+var subscriptionColumns = `s.id, s.service_id, s.service_name, ` + categoryColumn + `,
+	COALESCE(` + inEffectTodaySQL("subscription_prices", "price", "s.id") + `, s.price) AS price,
+	COALESCE(` + inEffectTodaySQL("subscription_seats", "seats", "s.id") + `, s.seats) AS seats,
+	s.currency, s.billing_period, s.user_id,
+	s.start_date, s.end_date, s.trial_end_date, s.trial_price, pause.paused_at,
+	s.cancelled_at, s.cancellation_reason, s.approval, s.vendor_id, ` + vendorColumn + `,
+	s.minimum_term_months, s.notice_period_days, s.renewal_owner,
+	CASE
+		WHEN s.approval = 'pending' THEN 'pending_approval'
+		WHEN s.approval = 'rejected' THEN 'rejected'
+		WHEN s.end_date < CURRENT_DATE THEN 'ended'
+		WHEN s.start_date > CURRENT_DATE THEN 'upcoming'
+		WHEN pause.paused_at <= CURRENT_DATE THEN 'paused'
+		ELSE 'active'
+	END AS status,
+	(
+		SELECT MIN(c.day)
+		FROM (` + chargeDates("CURRENT_DATE", "CURRENT_DATE + 366") + `) c
+	) AS next_renewal`
+
+// inEffectTodaySQL picks column of the period in table that is in effect today
+// for subscription id, or of its earliest period while none has started yet.
+// A period dated in the future only takes over on its day.
+func inEffectTodaySQL(table, column, id string) string {
+	return fmt.Sprintf(`COALESCE(
+		(
+			SELECT %[2]s
+			FROM %[1]s
+			WHERE subscription_id = %[3]s
+			  AND effective_from <= CURRENT_DATE
+			ORDER BY effective_from DESC
+			LIMIT 1
+		),
+		(
+			SELECT %[2]s
+			FROM %[1]s
+			WHERE subscription_id = %[3]s
+			ORDER BY effective_from
+			LIMIT 1
+		)
+	)`, table, column, id)
+}
+
+// openPauseJoin attaches the pause in progress, if any, to subscriptions s.
+const openPauseJoin = `
+	LEFT JOIN LATERAL (
+		SELECT sp.paused_at
+		FROM subscription_pauses sp
+		WHERE sp.subscription_id = s.id AND sp.resumed_at IS NULL
+	) AS pause ON true`
+
 type SubscriptionRepository struct {
 	db *sqlx.DB
 }
@@ -23,38 +81,84 @@ func NewSubscriptionRepo(db *sqlx.DB) *SubscriptionRepository {
 	}
 }
 
+// Create inserts the subscription together with its initial price period, its
+// category, which is added when new, and its tags.
 func (r *SubscriptionRepository) Create(ctx context.Context, s *entity.Subscription) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	categoryID, err := ensureCategory(ctx, tx, s.Category)
+	if err != nil {
+		return 0, err
+	}
+
 	query := `
-		INSERT INTO subscriptions
-			(service_name, price, user_id, start_date, end_date)
-		VALUES 
-		    ($1, $2, $3, $4, $5)
-		RETURNING id
+		WITH sub AS (
+			INSERT INTO subscriptions
+				(service_name, price, currency, billing_period, user_id, start_date, end_date,
+				 trial_end_date, trial_price, service_id, category_id, seats, approval,
+				 vendor_id, minimum_term_months, notice_period_days, renewal_owner)
+			VALUES 
+			    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+			RETURNING id, price, seats, start_date
+		), initial_price AS (
+			INSERT INTO subscription_prices
+				(subscription_id, price, effective_from)
+			SELECT id, price, start_date FROM sub
+		), initial_seats AS (
+			INSERT INTO subscription_seats
+				(subscription_id, seats, effective_from)
+			SELECT id, seats, start_date FROM sub
+		)
+		SELECT id FROM sub
 	`
 
 	var id int
-	err := r.db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		s.ServiceName,
 		s.Price,
+		s.Currency,
+		s.BillingPeriod,
 		s.UserID,
 		s.StartDate,
 		s.EndDate,
+		s.TrialEndDate,
+		s.TrialPrice,
+		s.ServiceID,
+		categoryID,
+		seatsOrOne(s.Seats),
+		approvalOrApproved(s.Approval),
+		s.VendorID,
+		s.MinimumTermMonths,
+		s.NoticePeriodDays,
+		s.RenewalOwner,
 	).Scan(&id)
 
 	if err != nil {
 		return 0, fmt.Errorf("failed to create subscription using db: %w", err)
 	}
 
+	if err := replaceTags(ctx, tx, id, s.Tags); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit subscription: %w", err)
+	}
+
 	return id, nil
 }
 
 func (r *SubscriptionRepository) Get(ctx context.Context, id int) (*entity.Subscription, error) {
 	query := `
-		SELECT id, service_name, price, user_id, start_date, end_date
-		FROM subscriptions
-		WHERE id = $1
+		SELECT ` + subscriptionColumns + `
+		FROM subscriptions s` + openPauseJoin + `
+		WHERE s.id = $1
 	`
 
 	var sub entity.Subscription
@@ -67,29 +171,85 @@ func (r *SubscriptionRepository) Get(ctx context.Context, id int) (*entity.Subsc
 		return nil, fmt.Errorf("failed to get subscription using db: %w", err)
 	}
 
-	return &sub, nil
+	subs := []entity.Subscription{sub}
+	if err := attachTags(ctx, r.db, subs); err != nil {
+		return nil, err
+	}
+	if err := attachMembers(ctx, r.db, subs); err != nil {
+		return nil, err
+	}
+	if err := attachAllocations(ctx, r.db, subs); err != nil {
+		return nil, err
+	}
+
+	return &subs[0], nil
 }
 
+// Update overwrites everything but the price, which only changes through
+// ChangePrice so that its history is kept.
 func (r *SubscriptionRepository) Update(ctx context.Context, s *entity.Subscription) error {
+	return r.update(ctx, s, nil)
+}
+
+// UpdateContract is Update replacing the early termination fee of the
+// subscription in the same transaction; a nil fee removes it.
+func (r *SubscriptionRepository) UpdateContract(ctx context.Context, s *entity.Subscription, fee *entity.OneOffCharge) error {
+	return r.update(ctx, s, func(tx *sqlx.Tx) error {
+		return replaceTerminationFee(ctx, tx, s.ID, fee)
+	})
+}
+
+// update overwrites the subscription and runs then, if given, within the same
+// transaction.
+func (r *SubscriptionRepository) update(ctx context.Context, s *entity.Subscription, then func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	categoryID, err := ensureCategory(ctx, tx, s.Category)
+	if err != nil {
+		return err
+	}
+
 	query := `
         UPDATE subscriptions
         SET 
-            service_name = $1,
-            price        = $2,
-            user_id      = $3,
-            start_date   = $4,
-            end_date     = $5
-        WHERE id = $6
+            service_name        = $1,
+            currency            = $2,
+            billing_period      = $3,
+            user_id             = $4,
+            start_date          = $5,
+            end_date            = $6,
+            trial_end_date      = $7,
+            trial_price         = $8,
+            service_id          = $9,
+            category_id         = $10,
+            vendor_id           = $11,
+            minimum_term_months = $12,
+            notice_period_days  = $13,
+            renewal_owner       = $14
+        WHERE id = $15
     `
 
-	res, err := r.db.ExecContext(
+	res, err := tx.ExecContext(
 		ctx,
 		query,
 		s.ServiceName,
-		s.Price,
+		s.Currency,
+		s.BillingPeriod,
 		s.UserID,
 		s.StartDate,
 		s.EndDate,
+		s.TrialEndDate,
+		s.TrialPrice,
+		s.ServiceID,
+		categoryID,
+		s.VendorID,
+		s.MinimumTermMonths,
+		s.NoticePeriodDays,
+		s.RenewalOwner,
 		s.ID,
 	)
 
@@ -106,9 +266,398 @@ func (r *SubscriptionRepository) Update(ctx context.Context, s *entity.Subscript
 		return sql.ErrNoRows
 	}
 
+	if err := replaceTags(ctx, tx, s.ID, s.Tags); err != nil {
+		return err
+	}
+
+	if then != nil {
+		if err := then(tx); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit subscription: %w", err)
+	}
+
 	return nil
 }
 
+// ChangePrice appends a price period, replacing one that starts on the same
+// day, and keeps subscriptions.price in line with the period in effect today.
+func (r *SubscriptionRepository) ChangePrice(ctx context.Context, p entity.PricePeriod) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+	err = tx.GetContext(ctx, &id, `SELECT id FROM subscriptions WHERE id = $1 FOR UPDATE`, p.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		return fmt.Errorf("failed to lock subscription using db: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO subscription_prices
+			(subscription_id, price, effective_from)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (subscription_id, effective_from) DO UPDATE
+		SET price = EXCLUDED.price
+	`, p.SubscriptionID, p.Price, p.EffectiveFrom)
+	if err != nil {
+		return fmt.Errorf("failed to add price period using db: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE subscriptions
+		SET price = `+inEffectTodaySQL("subscription_prices", "price", "$1")+`
+		WHERE id = $1
+	`, p.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to update current price using db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit price change: %w", err)
+	}
+
+	return nil
+}
+
+// ChangeSeats appends a seat period, replacing one that starts on the same
+// day, and keeps subscriptions.seats in line with the period in effect today.
+func (r *SubscriptionRepository) ChangeSeats(ctx context.Context, p entity.SeatPeriod) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+	err = tx.GetContext(ctx, &id, `SELECT id FROM subscriptions WHERE id = $1 FOR UPDATE`, p.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		return fmt.Errorf("failed to lock subscription using db: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO subscription_seats
+			(subscription_id, seats, effective_from)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (subscription_id, effective_from) DO UPDATE
+		SET seats = EXCLUDED.seats
+	`, p.SubscriptionID, p.Seats, p.EffectiveFrom)
+	if err != nil {
+		return fmt.Errorf("failed to add seat period using db: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE subscriptions
+		SET seats = `+inEffectTodaySQL("subscription_seats", "seats", "$1")+`
+		WHERE id = $1
+	`, p.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to update current seats using db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit seat change: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SubscriptionRepository) GetPriceHistory(ctx context.Context, id int) ([]entity.PricePeriod, error) {
+	query := `
+		SELECT id, subscription_id, price, effective_from
+		FROM subscription_prices
+		WHERE subscription_id = $1
+		ORDER BY effective_from
+	`
+
+	var periods []entity.PricePeriod
+	if err := r.db.SelectContext(ctx, &periods, query, id); err != nil {
+		return nil, fmt.Errorf("failed to get price history using db: %w", err)
+	}
+
+	return periods, nil
+}
+
+// Pause opens a pause from p.PausedAt on, or returns sql.ErrNoRows when an
+// earlier pause is still running on that day.
+func (r *SubscriptionRepository) Pause(ctx context.Context, p entity.Pause) error {
+	query := `
+		INSERT INTO subscription_pauses
+			(subscription_id, paused_at)
+		SELECT $1, $2
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM subscription_pauses sp
+			WHERE sp.subscription_id = $1
+			  AND (sp.resumed_at IS NULL OR sp.resumed_at > $2)
+		)
+	`
+
+	res, err := r.db.ExecContext(ctx, query, p.SubscriptionID, p.PausedAt)
+	if err != nil {
+		return fmt.Errorf("failed to pause subscription using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Resume closes the pause in progress.
+func (r *SubscriptionRepository) Resume(ctx context.Context, id int, resumedAt time.Time) error {
+	query := `
+		UPDATE subscription_pauses
+		SET resumed_at = $2
+		WHERE subscription_id = $1 AND resumed_at IS NULL
+	`
+
+	res, err := r.db.ExecContext(ctx, query, id, resumedAt)
+	if err != nil {
+		return fmt.Errorf("failed to resume subscription using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Cancel stores the end date and cancellation details of a subscription that
+// has not been cancelled yet, and replaces its early termination fee in the
+// same transaction; a nil fee removes it.
+func (r *SubscriptionRepository) Cancel(ctx context.Context, s *entity.Subscription, fee *entity.OneOffCharge) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE subscriptions
+		SET
+			end_date            = $1,
+			cancelled_at        = $2,
+			cancellation_reason = $3
+		WHERE id = $4 AND cancelled_at IS NULL
+	`
+
+	res, err := tx.ExecContext(ctx, query, s.EndDate, s.CancelledAt, s.CancellationReason, s.ID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel subscription using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	if err := replaceTerminationFee(ctx, tx, s.ID, fee); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit cancellation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SubscriptionRepository) AddMember(ctx context.Context, m entity.Member) error {
+	query := `
+		INSERT INTO subscription_members
+			(subscription_id, user_id, share_percent, share_amount)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (subscription_id, user_id) DO UPDATE
+		SET share_percent = EXCLUDED.share_percent,
+			share_amount  = EXCLUDED.share_amount
+	`
+
+	_, err := r.db.ExecContext(ctx, query, m.SubscriptionID, m.UserID, m.SharePercent, m.ShareAmount)
+	if err != nil {
+		return fmt.Errorf("failed to add subscription member using db: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SubscriptionRepository) RemoveMember(ctx context.Context, subscriptionID int, userID uuid.UUID) error {
+	query := `
+		DELETE FROM subscription_members
+		WHERE subscription_id = $1 AND user_id = $2
+	`
+
+	res, err := r.db.ExecContext(ctx, query, subscriptionID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove subscription member using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *SubscriptionRepository) SetAllocations(ctx context.Context, id int, allocations []entity.Allocation) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var locked int
+	err = tx.GetContext(ctx, &locked, `SELECT id FROM subscriptions WHERE id = $1 FOR UPDATE`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		return fmt.Errorf("failed to lock subscription using db: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM subscription_allocations WHERE subscription_id = $1`, id); err != nil {
+		return fmt.Errorf("failed to clear subscription allocations using db: %w", err)
+	}
+
+	for _, a := range allocations {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO subscription_allocations
+				(subscription_id, cost_centre_id, percent)
+			VALUES
+				($1, $2, $3)
+		`, id, a.CostCentreID, a.Percent)
+		if err != nil {
+			return fmt.Errorf("failed to add subscription allocation using db: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit subscription allocations: %w", err)
+	}
+
+	return nil
+}
+
+// replaceTerminationFee sets the early termination fee of the subscription,
+// which the caller has locked, to fee; a nil fee removes it.
+func replaceTerminationFee(ctx context.Context, tx *sqlx.Tx, id int, fee *entity.OneOffCharge) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM one_off_charges WHERE subscription_id = $1 AND termination_fee`, id)
+	if err != nil {
+		return fmt.Errorf("failed to clear termination fee using db: %w", err)
+	}
+
+	if fee == nil {
+		return nil
+	}
+
+	err = tx.GetContext(ctx, &fee.ID, `
+		INSERT INTO one_off_charges
+			(subscription_id, user_id, charged_on, amount, currency, description, termination_fee)
+		VALUES
+			($1, $2, $3, $4, $5, $6, true)
+		RETURNING id
+	`, id, fee.UserID, fee.Date, fee.Amount, fee.Currency, fee.Description)
+	if err != nil {
+		return fmt.Errorf("failed to add termination fee using db: %w", err)
+	}
+	fee.SubscriptionID = &id
+	fee.TerminationFee = true
+
+	return nil
+}
+
+// Decide records the decision on the pending subscription and moves it out of
+// the approval workflow. It returns sql.ErrNoRows when the subscription is not
+// pending.
+func (r *SubscriptionRepository) Decide(ctx context.Context, d *entity.ApprovalDecision) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE subscriptions
+		SET approval = $1
+		WHERE id = $2 AND approval = 'pending'
+	`, d.Decision, d.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to update subscription approval using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO subscription_approvals
+			(subscription_id, decision, decided_by, comment)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING id, decided_at
+	`, d.SubscriptionID, d.Decision, d.DecidedBy, d.Comment).Scan(&d.ID, &d.DecidedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record approval decision using db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit approval decision: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SubscriptionRepository) GetApprovals(ctx context.Context, id int) ([]entity.ApprovalDecision, error) {
+	query := `
+		SELECT id, subscription_id, decision, decided_by, comment, decided_at
+		FROM subscription_approvals
+		WHERE subscription_id = $1
+		ORDER BY decided_at, id
+	`
+
+	var decisions []entity.ApprovalDecision
+	if err := r.db.SelectContext(ctx, &decisions, query, id); err != nil {
+		return nil, fmt.Errorf("failed to get approval decisions using db: %w", err)
+	}
+
+	return decisions, nil
+}
+
 func (r *SubscriptionRepository) Delete(ctx context.Context, id int) error {
 	query := `
 		DELETE FROM subscriptions
@@ -143,19 +692,80 @@ func (r *SubscriptionRepository) GetList(ctx context.Context, f filter.ListFilte
 	}
 
 	if f.ServiceName != nil {
-		where = append(where, fmt.Sprintf("service_name = $%d", len(args)+1))
+		where = append(where, serviceNameMatchSQL(len(args)+1))
 		args = append(args, *f.ServiceName)
 	}
 
+	if f.Category != nil {
+		where = append(where, categoryMatchSQL(len(args)+1))
+		args = append(args, *f.Category)
+	}
+
+	if f.Tag != nil {
+		where = append(where, tagMatchSQL(len(args)+1))
+		args = append(args, *f.Tag)
+	}
+
+	if f.Currency != nil {
+		where = append(where, fmt.Sprintf("currency = $%d", len(args)+1))
+		args = append(args, *f.Currency)
+	}
+
+	if f.InTrial != nil {
+		if *f.InTrial {
+			where = append(where, "trial_end_date >= CURRENT_DATE")
+		} else {
+			where = append(where, "(trial_end_date IS NULL OR trial_end_date < CURRENT_DATE)")
+		}
+	}
+
+	if f.Cancelled != nil {
+		if *f.Cancelled {
+			where = append(where, "cancelled_at IS NOT NULL")
+		} else {
+			where = append(where, "cancelled_at IS NULL")
+		}
+	}
+
+	if f.Active != nil {
+		active := "start_date <= CURRENT_DATE AND (end_date IS NULL OR end_date >= CURRENT_DATE)"
+		if *f.Active {
+			where = append(where, "("+active+")")
+		} else {
+			where = append(where, "NOT ("+active+")")
+		}
+	}
+
+	if f.ScheduledToEnd != nil {
+		if *f.ScheduledToEnd {
+			where = append(where, "end_date >= CURRENT_DATE")
+		} else {
+			where = append(where, "(end_date IS NULL OR end_date < CURRENT_DATE)")
+		}
+	}
+
+	if f.Pending != nil {
+		if *f.Pending {
+			where = append(where, "approval = 'pending'")
+		} else {
+			where = append(where, "approval <> 'pending'")
+		}
+	}
+
 	query := `
-		SELECT id, service_name, price, user_id, start_date, end_date
-		FROM subscriptions
+		SELECT ` + subscriptionColumns + `
+		FROM subscriptions s` + openPauseJoin + `
 	`
 
 	if len(where) > 0 {
 		query += " WHERE " + strings.Join(where, " AND ")
 	}
 
+	// trials that convert to paid soonest come first
+	if f.InTrial != nil && *f.InTrial {
+		query += " ORDER BY trial_end_date, id"
+	}
+
 	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
 	args = append(args, f.Limit, f.Offset)
 
@@ -164,46 +774,335 @@ func (r *SubscriptionRepository) GetList(ctx context.Context, f filter.ListFilte
 		return nil, fmt.Errorf("failed to get list of subscriptions using db: %w", err)
 	}
 
+	if err := attachTags(ctx, r.db, subs); err != nil {
+		return nil, err
+	}
+	if err := attachMembers(ctx, r.db, subs); err != nil {
+		return nil, err
+	}
+	if err := attachAllocations(ctx, r.db, subs); err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+// GetTotalSum returns one total per currency; amounts are never mixed across
+// currencies.
+func (r *SubscriptionRepository) GetTotalSum(ctx context.Context, f filter.SumFilter) ([]entity.Money, error) {
+	charges, args := monthlyCharges(f)
+
+	query := `
+		SELECT c.currency, ROUND(SUM(c.amount))::bigint AS amount
+		FROM (` + charges + `) c
+		GROUP BY c.currency
+		ORDER BY c.currency`
+
+	var totals []entity.Money
+	if err := r.db.SelectContext(ctx, &totals, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get total sum using db: %w", err)
+	}
+
+	return totals, nil
+}
+
+func (r *SubscriptionRepository) GetMonthlySums(ctx context.Context, f filter.SumFilter) ([]entity.MonthlyAmount, error) {
+	charges, args := monthlyCharges(f)
+
+	query := `
+		SELECT c.month, c.currency, ROUND(SUM(c.amount))::bigint AS amount
+		FROM (` + charges + `) c
+		GROUP BY c.month, c.currency
+		ORDER BY c.month, c.currency`
+
+	var sums []entity.MonthlyAmount
+	if err := r.db.SelectContext(ctx, &sums, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get monthly sums using db: %w", err)
+	}
+
+	return sums, nil
+}
+
+// GetMonthlySeries returns the monthly sums of every calendar month between the
+// filter's start and end dates, both of which must be set. A month without
+// charges comes as a single row with an empty currency and a zero amount.
+func (r *SubscriptionRepository) GetMonthlySeries(ctx context.Context, f filter.SumFilter) ([]entity.MonthlyAmount, error) {
+	charges, args := monthlyCharges(f)
+
+	query := `
+		SELECT m.month::date AS month, COALESCE(t.currency, '') AS currency, COALESCE(t.amount, 0) AS amount
+		FROM generate_series(
+			date_trunc('month', $1::date),
+			date_trunc('month', $2::date),
+			interval '1 month'
+		) AS m(month)
+		LEFT JOIN (
+			SELECT c.month, c.currency, ROUND(SUM(c.amount))::bigint AS amount
+			FROM (` + charges + `) c
+			GROUP BY c.month, c.currency
+		) t ON t.month = m.month::date
+		ORDER BY m.month, t.currency`
+
+	var sums []entity.MonthlyAmount
+	if err := r.db.SelectContext(ctx, &sums, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get monthly series using db: %w", err)
+	}
+
+	return sums, nil
+}
+
+// GetForecast returns the monthly sums of every calendar month between the
+// filter's start and end dates, both of which must be set, split into
+// committed and assumed amounts. A month without charges comes as a single
+// row with an empty currency and a zero amount.
+func (r *SubscriptionRepository) GetForecast(ctx context.Context, f filter.SumFilter) ([]entity.ForecastAmount, error) {
+	charges, args := monthlyCharges(f)
+
+	query := `
+		SELECT m.month::date AS month, COALESCE(t.committed, false) AS committed,
+			COALESCE(t.currency, '') AS currency, COALESCE(t.amount, 0) AS amount
+		FROM generate_series(
+			date_trunc('month', $1::date),
+			date_trunc('month', $2::date),
+			interval '1 month'
+		) AS m(month)
+		LEFT JOIN (
+			SELECT c.month, c.committed, c.currency, ROUND(SUM(c.amount))::bigint AS amount
+			FROM (` + charges + `) c
+			GROUP BY c.month, c.committed, c.currency
+		) t ON t.month = m.month::date
+		ORDER BY m.month, t.committed DESC, t.currency`
+
+	var sums []entity.ForecastAmount
+	if err := r.db.SelectContext(ctx, &sums, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get forecast using db: %w", err)
+	}
+
+	return sums, nil
+}
+
+// GetChargeback returns one amount per cost centre and currency, spreading the
+// spend of every subscription over the cost centres it is allocated to. The
+// unallocated spend comes last, without a cost centre.
+func (r *SubscriptionRepository) GetChargeback(ctx context.Context, f filter.SumFilter) ([]entity.ChargebackAmount, error) {
+	charges, args := monthlyCharges(f)
+
+	query := `
+		SELECT al.cost_centre_id, cc.name AS cost_centre_name, c.currency,
+			ROUND(SUM(c.amount * al.percent / 100))::bigint AS amount
+		FROM (` + charges + `) c
+		CROSS JOIN LATERAL (` + allocationsSQL + `) AS al
+		LEFT JOIN cost_centres cc ON cc.id = al.cost_centre_id
+		GROUP BY al.cost_centre_id, cc.name, c.currency
+		ORDER BY lower(cc.name) NULLS LAST, al.cost_centre_id, c.currency`
+
+	var amounts []entity.ChargebackAmount
+	if err := r.db.SelectContext(ctx, &amounts, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get chargeback using db: %w", err)
+	}
+
+	return amounts, nil
+}
+
+// sumGroupColumns maps breakdown keys to columns of monthlyCharges.
+var sumGroupColumns = map[filter.SumGroup]string{
+	filter.SumGroupServiceName: "c.service_name",
+	filter.SumGroupUserID:      "c.user_id",
+	filter.SumGroupMonth:       "c.month",
+}
+
+// GetGroupedSums returns one amount per currency and combination of the keys
+// in f.GroupBy, ordered by those keys.
+func (r *SubscriptionRepository) GetGroupedSums(ctx context.Context, f filter.SumFilter) ([]entity.GroupedAmount, error) {
+	charges, args := monthlyCharges(f)
+
+	var keys []string
+	for _, g := range f.GroupBy {
+		col, ok := sumGroupColumns[g]
+		if !ok {
+			return nil, fmt.Errorf("unknown sum group %q", g)
+		}
+		keys = append(keys, col)
+	}
+	keys = append(keys, "c.currency")
+	group := strings.Join(keys, ", ")
+
+	query := `
+		SELECT ` + group + `, ROUND(SUM(c.amount))::bigint AS amount
+		FROM (` + charges + `) c
+		GROUP BY ` + group + `
+		ORDER BY ` + group
+
+	var sums []entity.GroupedAmount
+	if err := r.db.SelectContext(ctx, &sums, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get grouped sums using db: %w", err)
+	}
+
+	return sums, nil
+}
+
+// GetRenewals lists every charge falling due within the filter window, soonest
+// first, at the price in effect on its day.
+func (r *SubscriptionRepository) GetRenewals(ctx context.Context, f filter.RenewalFilter) ([]entity.Renewal, error) {
+	args := []any{f.From, f.To}
+	var where []string
+
+	if f.UserID != nil {
+		where = append(where, fmt.Sprintf("s.user_id = $%d", len(args)+1))
+		args = append(args, *f.UserID)
+	}
+
+	query := `
+		SELECT s.id AS subscription_id, s.service_name, ref.day AS date, s.currency, p.price AS amount
+		FROM subscriptions s
+		CROSS JOIN LATERAL (` + chargeDates("$1::date", "$2::date") + `) AS ref
+		CROSS JOIN LATERAL (` + priceAtSQL + `) AS p`
+
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	query += " ORDER BY ref.day, s.id"
+
+	var renewals []entity.Renewal
+	if err := r.db.SelectContext(ctx, &renewals, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get renewals using db: %w", err)
+	}
+
+	return renewals, nil
+}
+
+// GetContracts lists the approved subscriptions with a notice period and no
+// end date, which renew unless cancelled in time.
+func (r *SubscriptionRepository) GetContracts(ctx context.Context, f filter.ContractFilter) ([]entity.Subscription, error) {
+	where := []string{
+		"s.approval = 'approved'",
+		"s.notice_period_days IS NOT NULL",
+		"s.end_date IS NULL",
+	}
+	var args []any
+
+	if f.RenewalOwner != nil {
+		where = append(where, fmt.Sprintf("s.renewal_owner = $%d", len(args)+1))
+		args = append(args, *f.RenewalOwner)
+	}
+
+	if f.VendorID != nil {
+		where = append(where, fmt.Sprintf("s.vendor_id = $%d", len(args)+1))
+		args = append(args, *f.VendorID)
+	}
+
+	query := `
+		SELECT ` + subscriptionColumns + `
+		FROM subscriptions s` + openPauseJoin + `
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY s.id`
+
+	var subs []entity.Subscription
+	if err := r.db.SelectContext(ctx, &subs, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get contracts using db: %w", err)
+	}
+
 	return subs, nil
 }
 
-func (r *SubscriptionRepository) GetTotalSum(ctx context.Context, f filter.SumFilter) (int, error) {
+// periodsIntersectSQL matches subscriptions a and b whose periods have a day
+// in common; an open end runs forever.
+const periodsIntersectSQL = `a.start_date <= COALESCE(b.end_date, 'infinity'::date)
+	AND b.start_date <= COALESCE(a.end_date, 'infinity'::date)`
+
+// sameServiceSQL matches subscriptions a and b of one service: the same
+// catalog entry when both are filed under one, whatever name or alias they
+// were stored by, and the same name in any case otherwise.
+const sameServiceSQL = `CASE
+		WHEN a.service_id IS NOT NULL AND b.service_id IS NOT NULL THEN a.service_id = b.service_id
+		ELSE lower(a.service_name) = lower(b.service_name)
+	END`
+
+func (r *SubscriptionRepository) FindOverlap(ctx context.Context, s *entity.Subscription) (int, error) {
 	query := `
-		SELECT COALESCE(SUM(price), 0)
-		FROM subscriptions
-		WHERE 1=1
+		SELECT a.id
+		FROM subscriptions a
+		CROSS JOIN (
+			SELECT $2::int AS service_id, $3::text AS service_name,
+				$4::date AS start_date, $5::date AS end_date
+		) AS b
+		WHERE a.user_id = $1
+		  AND a.approval <> 'rejected'
+		  AND ` + sameServiceSQL + `
+		  AND ` + periodsIntersectSQL + `
+		ORDER BY a.id
+		LIMIT 1
 	`
 
+	var id int
+	err := r.db.GetContext(ctx, &id, query, s.UserID, s.ServiceID, s.ServiceName, s.StartDate, s.EndDate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("failed to find overlapping subscription using db: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetOverlaps lists every pair of overlapping subscriptions of one service
+// once, ordered by user, service and ids. Rejected subscriptions overlap with
+// none.
+func (r *SubscriptionRepository) GetOverlaps(ctx context.Context, f filter.DuplicateFilter) ([]entity.Overlap, error) {
+	where := []string{"a.approval <> 'rejected'"}
 	var args []any
-	argNum := 1
 
 	if f.UserID != nil {
-		query += fmt.Sprintf(" AND user_id = $%d", argNum)
+		where = append(where, fmt.Sprintf("a.user_id = $%d", len(args)+1))
 		args = append(args, *f.UserID)
-		argNum++
 	}
 
-	if f.ServiceName != nil {
-		query += fmt.Sprintf(" AND service_name = $%d", argNum)
-		args = append(args, *f.ServiceName)
-		argNum++
-	}
+	query := `
+		SELECT a.user_id, a.service_name, a.id AS first_id, b.id AS second_id,
+			GREATEST(a.start_date, b.start_date) AS overlap_from,
+			NULLIF(
+				LEAST(COALESCE(a.end_date, 'infinity'::date), COALESCE(b.end_date, 'infinity'::date)),
+				'infinity'::date
+			) AS overlap_to
+		FROM subscriptions a
+		JOIN subscriptions b
+			ON b.user_id = a.user_id
+			AND ` + sameServiceSQL + `
+			AND b.id > a.id
+			AND b.approval <> 'rejected'
+			AND ` + periodsIntersectSQL
 
-	if f.StartDate != nil {
-		query += fmt.Sprintf(" AND start_date >= $%d", argNum)
-		args = append(args, *f.StartDate)
-		argNum++
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
 	}
 
-	if f.EndDate != nil {
-		query += fmt.Sprintf(" AND start_date <= $%d", argNum)
-		args = append(args, *f.EndDate)
+	query += " ORDER BY a.user_id, lower(a.service_name), a.id, b.id"
+	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, f.Limit, f.Offset)
+
+	var overlaps []entity.Overlap
+	if err := r.db.SelectContext(ctx, &overlaps, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get overlapping subscriptions using db: %w", err)
 	}
 
-	var sum int
-	if err := r.db.GetContext(ctx, &sum, query, args...); err != nil {
-		return 0, fmt.Errorf("failed to get total sum using db: %w", err)
+	return overlaps, nil
+}
+
+// approvalOrApproved treats a subscription built outside of the approval
+// workflow as approved.
+func approvalOrApproved(a entity.Approval) entity.Approval {
+	if a == "" {
+		return entity.ApprovalApproved
 	}
+	return a
+}
 
-	return sum, nil
+// seatsOrOne counts a subscription built without a seat count as one seat.
+func seatsOrOne(seats int) int {
+	if seats < 1 {
+		return 1
+	}
+	return seats
 }
@@ -5,6 +5,7 @@ package db_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -25,7 +26,7 @@ func setupDB(t *testing.T) *sqlx.DB {
 	dbx, err := sqlx.Connect("pgx", dsn)
 	require.NoError(t, err)
 
-	_, err = dbx.Exec("TRUNCATE subscriptions RESTART IDENTITY CASCADE")
+	_, err = dbx.Exec("TRUNCATE subscriptions, exchange_rates, budgets, services, categories, cost_centres, vendors, one_off_charges RESTART IDENTITY CASCADE")
 	require.NoError(t, err)
 
 	return dbx
@@ -36,10 +37,12 @@ func TestPostgres_Create_Get(t *testing.T) {
 	repo := db.NewSubscriptionRepo(dbx)
 
 	sub := &entity.Subscription{
-		ServiceName: "Netflix",
-		Price:       500,
-		UserID:      uuid.New(),
-		StartDate:   time.Now(),
+		ServiceName:   "Netflix",
+		Price:         500,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uuid.New(),
+		StartDate:     time.Now(),
 	}
 
 	id, err := repo.Create(context.Background(), sub)
@@ -57,14 +60,17 @@ func TestPostgres_Update(t *testing.T) {
 	repo := db.NewSubscriptionRepo(dbx)
 
 	sub := &entity.Subscription{
-		ServiceName: "Spotify",
-		Price:       300,
-		UserID:      uuid.New(),
-		StartDate:   time.Now(),
+		ServiceName:   "Spotify",
+		Price:         300,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uuid.New(),
+		StartDate:     time.Now(),
 	}
 	id, _ := repo.Create(context.Background(), sub)
 
 	sub.ID = id
+	sub.ServiceName = "Spotify Family"
 	sub.Price = 999
 
 	err := repo.Update(context.Background(), sub)
@@ -72,7 +78,26 @@ func TestPostgres_Update(t *testing.T) {
 
 	got, err := repo.Get(context.Background(), id)
 	require.NoError(t, err)
-	require.Equal(t, 999, got.Price)
+	require.Equal(t, "Spotify Family", got.ServiceName)
+	require.Equal(t, 300, got.Price) // price only changes through ChangePrice
+
+	err = repo.ChangePrice(context.Background(), entity.PricePeriod{
+		SubscriptionID: id,
+		Price:          999,
+		EffectiveFrom:  time.Now().AddDate(0, 1, 0),
+	})
+	require.NoError(t, err)
+
+	// the new price only takes over next month
+	got, err = repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, 300, got.Price)
+
+	history, err := repo.GetPriceHistory(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, 300, history[0].Price)
+	require.Equal(t, 999, history[1].Price)
 }
 
 func TestPostgres_Delete(t *testing.T) {
@@ -80,10 +105,12 @@ func TestPostgres_Delete(t *testing.T) {
 	repo := db.NewSubscriptionRepo(dbx)
 
 	sub := &entity.Subscription{
-		ServiceName: "Apple",
-		Price:       777,
-		UserID:      uuid.New(),
-		StartDate:   time.Now(),
+		ServiceName:   "Apple",
+		Price:         777,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uuid.New(),
+		StartDate:     time.Now(),
 	}
 	id, _ := repo.Create(context.Background(), sub)
 
@@ -101,16 +128,20 @@ func TestPostgres_GetList(t *testing.T) {
 	uid := uuid.New()
 
 	repo.Create(context.Background(), &entity.Subscription{
-		ServiceName: "A",
-		Price:       100,
-		UserID:      uid,
-		StartDate:   time.Now(),
+		ServiceName:   "A",
+		Price:         100,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Now(),
 	})
 	repo.Create(context.Background(), &entity.Subscription{
-		ServiceName: "B",
-		Price:       200,
-		UserID:      uid,
-		StartDate:   time.Now(),
+		ServiceName:   "B",
+		Price:         200,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Now(),
 	})
 
 	list, err := repo.GetList(context.Background(),
@@ -127,21 +158,1162 @@ func TestPostgres_GetTotalSum(t *testing.T) {
 	uid := uuid.New()
 
 	repo.Create(context.Background(), &entity.Subscription{
-		ServiceName: "A",
-		Price:       300,
-		UserID:      uid,
-		StartDate:   time.Now(),
+		ServiceName:   "A",
+		Price:         300,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Now(),
 	})
 	repo.Create(context.Background(), &entity.Subscription{
-		ServiceName: "B",
-		Price:       700,
-		UserID:      uid,
-		StartDate:   time.Now(),
+		ServiceName:   "B",
+		Price:         700,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Now(),
 	})
 
-	sum, err := repo.GetTotalSum(context.Background(),
+	totals, err := repo.GetTotalSum(context.Background(),
 		filter.SumFilter{UserID: &uid},
 	)
 	require.NoError(t, err)
-	require.Equal(t, 1000, sum)
+	require.Equal(t, []entity.Money{{Amount: 1000, Currency: "RUB"}}, totals)
+}
+
+func TestPostgres_CategoriesAndTags(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	cloud, cloudUpper := "cloud", "Cloud"
+
+	awsID, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "AWS",
+		Category:      &cloud,
+		Tags:          []string{"team-design", "prod"},
+		Price:         300,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	_, err = repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Dropbox",
+		Category:      &cloudUpper,
+		Price:         700,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	_, err = repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Netflix",
+		Tags:          []string{"Team-Design"},
+		Price:         500,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	// both spellings of the category are one category
+	got, err := repo.Get(context.Background(), awsID)
+	require.NoError(t, err)
+	require.Equal(t, "cloud", *got.Category)
+	require.Equal(t, []string{"prod", "team-design"}, got.Tags)
+
+	list, err := repo.GetList(context.Background(), filter.ListFilter{Category: &cloudUpper, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	tag := "TEAM-DESIGN"
+	list, err = repo.GetList(context.Background(), filter.ListFilter{Tag: &tag, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	totals, err := repo.GetTotalSum(context.Background(), filter.SumFilter{UserID: &uid, Category: &cloud})
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 1000, Currency: "RUB"}}, totals)
+
+	totals, err = repo.GetTotalSum(context.Background(), filter.SumFilter{UserID: &uid, Tag: &tag})
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 800, Currency: "RUB"}}, totals)
+
+	got.Category = nil
+	got.Tags = nil
+	require.NoError(t, repo.Update(context.Background(), got))
+
+	got, err = repo.Get(context.Background(), awsID)
+	require.NoError(t, err)
+	require.Nil(t, got.Category)
+	require.Empty(t, got.Tags)
+}
+
+func TestPostgres_GetTotalSum_Months(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(y int, m time.Month) time.Time {
+		return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	// started before the window, still running: Jan..Jun
+	repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "A",
+		Price:         100,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(2024, time.October),
+	})
+	// fully inside the window: Feb..Mar
+	end := date(2025, time.March)
+	repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "B",
+		Price:         1000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(2025, time.February),
+		EndDate:       &end,
+	})
+	// ended before the window
+	gone := date(2024, time.December)
+	repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "C",
+		Price:         5000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(2024, time.November),
+		EndDate:       &gone,
+	})
+
+	from, to := date(2025, time.January), date(2025, time.June)
+	totals, err := repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 6*100 + 2*1000, Currency: "RUB"}}, totals)
+}
+
+func TestPostgres_GetTotalSum_BillingPeriods(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Yearly",
+		Price:         12000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodYearly,
+		UserID:        uid,
+		StartDate:     start,
+	})
+	repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Quarterly",
+		Price:         3000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodQuarterly,
+		UserID:        uid,
+		StartDate:     start,
+	})
+
+	to := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	totals, err := repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &start, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 3*1000 + 3*1000, Currency: "RUB"}}, totals)
+}
+
+func TestPostgres_GetTotalSum_Currencies(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, s := range []entity.Subscription{
+		{ServiceName: "A", Price: 10, Currency: "EUR"},
+		{ServiceName: "B", Price: 20, Currency: "USD"},
+		{ServiceName: "C", Price: 30, Currency: "EUR"},
+	} {
+		s.BillingPeriod = entity.BillingPeriodMonthly
+		s.UserID = uid
+		s.StartDate = start
+		_, err := repo.Create(context.Background(), &s)
+		require.NoError(t, err)
+	}
+
+	totals, err := repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &start, EndDate: &start},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{
+		{Amount: 40, Currency: "EUR"},
+		{Amount: 20, Currency: "USD"},
+	}, totals)
+}
+
+func TestPostgres_GetTotalSum_PriceHistory(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	id, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Netflix",
+		Price:         500,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(time.January, 10),
+	})
+	require.NoError(t, err)
+
+	// raised mid-March: March is still charged at the old price
+	err = repo.ChangePrice(context.Background(), entity.PricePeriod{
+		SubscriptionID: id,
+		Price:          800,
+		EffectiveFrom:  date(time.March, 15),
+	})
+	require.NoError(t, err)
+
+	from, to := date(time.January, 1), date(time.June, 30)
+	totals, err := repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 3*500 + 3*800, Currency: "RUB"}}, totals)
+}
+
+func TestPostgres_FuturePeriods(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	id, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Slack",
+		Price:         100,
+		Seats:         2,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uuid.New(),
+		StartDate:     today.AddDate(0, -2, 0),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.ChangePrice(context.Background(), entity.PricePeriod{
+		SubscriptionID: id,
+		Price:          150,
+		EffectiveFrom:  today.AddDate(0, 0, 10),
+	}))
+	require.NoError(t, repo.ChangeSeats(context.Background(), entity.SeatPeriod{
+		SubscriptionID: id,
+		Seats:          4,
+		EffectiveFrom:  today.AddDate(0, 0, 10),
+	}))
+
+	// periods dated in the future leave today's price and seats alone
+	got, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, 100, got.Price)
+	require.Equal(t, 2, got.Seats)
+
+	// one already in effect replaces them, the future ones still wait
+	require.NoError(t, repo.ChangePrice(context.Background(), entity.PricePeriod{
+		SubscriptionID: id,
+		Price:          120,
+		EffectiveFrom:  today,
+	}))
+
+	got, err = repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, 120, got.Price)
+	require.Equal(t, 2, got.Seats)
+
+	// a subscription that has not started yet is priced at its first period
+	upcoming, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Zoom",
+		Price:         200,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uuid.New(),
+		StartDate:     today.AddDate(0, 1, 0),
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.ChangePrice(context.Background(), entity.PricePeriod{
+		SubscriptionID: upcoming,
+		Price:          250,
+		EffectiveFrom:  today.AddDate(0, 2, 0),
+	}))
+
+	got, err = repo.Get(context.Background(), upcoming)
+	require.NoError(t, err)
+	require.Equal(t, 200, got.Price)
+}
+
+func TestPostgres_GetTotalSum_Seats(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	id, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Figma",
+		Price:         100,
+		Seats:         3,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(time.January, 1),
+	})
+	require.NoError(t, err)
+
+	// two more seats from April
+	err = repo.ChangeSeats(context.Background(), entity.SeatPeriod{
+		SubscriptionID: id,
+		Seats:          5,
+		EffectiveFrom:  date(time.April, 1),
+	})
+	require.NoError(t, err)
+
+	got, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, 5, got.Seats)
+
+	from, to := date(time.January, 1), date(time.June, 30)
+	totals, err := repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 3*300 + 3*500, Currency: "RUB"}}, totals)
+}
+
+func TestPostgres_GetTotalSum_Trial(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	// Jan and Feb are trial months, paid from March
+	trialEnd := date(time.February, 14)
+	_, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Okko",
+		Price:         400,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(time.January, 15),
+		TrialEndDate:  &trialEnd,
+		TrialPrice:    1,
+	})
+	require.NoError(t, err)
+
+	from, to := date(time.January, 1), date(time.April, 30)
+	totals, err := repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 2*1 + 2*400, Currency: "RUB"}}, totals)
+
+	inTrial := false
+	list, err := repo.GetList(context.Background(),
+		filter.ListFilter{UserID: &uid, InTrial: &inTrial, Limit: 10},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
+func TestPostgres_Pause_Resume(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	id, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "WorldClass",
+		Price:         500,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(time.January, 1),
+	})
+	require.NoError(t, err)
+
+	err = repo.Pause(context.Background(), entity.Pause{SubscriptionID: id, PausedAt: date(time.February, 10)})
+	require.NoError(t, err)
+
+	sub, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.NotNil(t, sub.PausedAt)
+	require.True(t, sub.PausedAt.Equal(date(time.February, 10)))
+	require.Equal(t, entity.SubscriptionStatusPaused, sub.Status)
+
+	err = repo.Resume(context.Background(), id, date(time.May, 5))
+	require.NoError(t, err)
+
+	err = repo.Resume(context.Background(), id, date(time.May, 6))
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	err = repo.Pause(context.Background(), entity.Pause{SubscriptionID: id, PausedAt: date(time.April, 1)})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	sub, err = repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Nil(t, sub.PausedAt)
+	require.Equal(t, entity.SubscriptionStatusActive, sub.Status)
+
+	// only March and April are paused from start to finish
+	from, to := date(time.January, 1), date(time.June, 30)
+	totals, err := repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 4 * 500, Currency: "RUB"}}, totals)
+}
+
+func TestPostgres_Cancel(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	start := time.Now().UTC().AddDate(0, -2, 0).Truncate(24 * time.Hour)
+	end := start.AddDate(1, 0, 0)
+
+	cancelledID, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Ivi",
+		Price:         299,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     start,
+	})
+	require.NoError(t, err)
+
+	fixedID, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Okko",
+		Price:         399,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     start,
+		EndDate:       &end,
+	})
+	require.NoError(t, err)
+
+	sub, err := repo.Get(context.Background(), cancelledID)
+	require.NoError(t, err)
+
+	periodEnd := sub.CurrentPeriodEnd(time.Now().UTC())
+	cancelledAt := time.Now().UTC().Truncate(time.Second)
+	reason := "not watching"
+	sub.EndDate = &periodEnd
+	sub.CancelledAt = &cancelledAt
+	sub.CancellationReason = &reason
+
+	require.NoError(t, repo.Cancel(context.Background(), sub, nil))
+	require.ErrorIs(t, repo.Cancel(context.Background(), sub, nil), sql.ErrNoRows)
+
+	got, err := repo.Get(context.Background(), cancelledID)
+	require.NoError(t, err)
+	require.True(t, got.EndDate.Equal(periodEnd))
+	require.True(t, got.CancelledAt.Equal(cancelledAt))
+	require.Equal(t, reason, *got.CancellationReason)
+
+	yes := true
+	list, err := repo.GetList(context.Background(),
+		filter.ListFilter{UserID: &uid, Cancelled: &yes, Limit: 10},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, cancelledID, list[0].ID)
+
+	// both end in the future, only one of them was cancelled
+	list, err = repo.GetList(context.Background(),
+		filter.ListFilter{UserID: &uid, ScheduledToEnd: &yes, Limit: 10},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	no := false
+	list, err = repo.GetList(context.Background(),
+		filter.ListFilter{UserID: &uid, Active: &yes, Cancelled: &no, Limit: 10},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, fixedID, list[0].ID)
+}
+
+func TestPostgres_GetRenewals(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	// charged on the last day of shorter months
+	monthlyID, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Netflix",
+		Price:         800,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(2024, time.October, 31),
+	})
+	require.NoError(t, err)
+
+	weeklyEnd := date(2025, time.February, 20)
+	weeklyID, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Gym",
+		Price:         1000,
+		Currency:      "EUR",
+		BillingPeriod: entity.BillingPeriodWeekly,
+		UserID:        uid,
+		StartDate:     date(2025, time.January, 2),
+		EndDate:       &weeklyEnd,
+	})
+	require.NoError(t, err)
+
+	renewals, err := repo.GetRenewals(context.Background(), filter.RenewalFilter{
+		UserID: &uid,
+		From:   date(2025, time.February, 1),
+		To:     date(2025, time.March, 31),
+	})
+	require.NoError(t, err)
+
+	type due struct {
+		id  int
+		day time.Time
+	}
+	var got []due
+	for _, r := range renewals {
+		got = append(got, due{r.SubscriptionID, r.Date})
+	}
+	require.Equal(t, []due{
+		{weeklyID, date(2025, time.February, 6)},
+		{weeklyID, date(2025, time.February, 13)},
+		{weeklyID, date(2025, time.February, 20)},
+		{monthlyID, date(2025, time.February, 28)},
+		{monthlyID, date(2025, time.March, 31)},
+	}, got)
+	require.Equal(t, entity.Money{Amount: 1000, Currency: "EUR"}, renewals[0].Money)
+
+	sub, err := repo.Get(context.Background(), monthlyID)
+	require.NoError(t, err)
+	require.NotNil(t, sub.NextRenewal)
+	require.False(t, sub.NextRenewal.Before(time.Now().UTC().Truncate(24*time.Hour)))
+	next := *sub.NextRenewal
+	require.True(t, next.Day() == 31 || next.AddDate(0, 0, 1).Day() == 1)
+
+	sub, err = repo.Get(context.Background(), weeklyID)
+	require.NoError(t, err)
+	require.Nil(t, sub.NextRenewal)
+}
+
+func TestPostgres_GetGroupedSums(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	for _, s := range []entity.Subscription{
+		{ServiceName: "Netflix", Price: 800, StartDate: date(time.January, 10)},
+		{ServiceName: "Spotify", Price: 300, StartDate: date(time.February, 1)},
+	} {
+		s.Currency = entity.DefaultCurrency
+		s.BillingPeriod = entity.BillingPeriodMonthly
+		s.UserID = uid
+		_, err := repo.Create(context.Background(), &s)
+		require.NoError(t, err)
+	}
+
+	from, to := date(time.January, 1), date(time.February, 28)
+	sums, err := repo.GetGroupedSums(context.Background(), filter.SumFilter{
+		UserID:    &uid,
+		StartDate: &from,
+		EndDate:   &to,
+		GroupBy:   []filter.SumGroup{filter.SumGroupMonth, filter.SumGroupServiceName},
+	})
+	require.NoError(t, err)
+	require.Len(t, sums, 3)
+
+	require.True(t, sums[0].Month.Equal(date(time.January, 1)))
+	require.Equal(t, "Netflix", *sums[0].ServiceName)
+	require.Nil(t, sums[0].UserID)
+	require.Equal(t, entity.Money{Amount: 800, Currency: "RUB"}, sums[0].Money)
+
+	require.True(t, sums[2].Month.Equal(date(time.February, 1)))
+	require.Equal(t, "Spotify", *sums[2].ServiceName)
+	require.Equal(t, entity.Money{Amount: 300, Currency: "RUB"}, sums[2].Money)
+}
+
+func TestPostgres_GetMonthlySeries(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	end := date(time.January, 31)
+
+	for _, s := range []entity.Subscription{
+		{ServiceName: "Netflix", Price: 800, StartDate: date(time.January, 10), EndDate: &end},
+		{ServiceName: "Spotify", Price: 300, StartDate: date(time.March, 1)},
+	} {
+		s.Currency = entity.DefaultCurrency
+		s.BillingPeriod = entity.BillingPeriodMonthly
+		s.UserID = uid
+		_, err := repo.Create(context.Background(), &s)
+		require.NoError(t, err)
+	}
+
+	from, to := date(time.January, 1), date(time.March, 31)
+	series, err := repo.GetMonthlySeries(context.Background(), filter.SumFilter{
+		UserID:    &uid,
+		StartDate: &from,
+		EndDate:   &to,
+	})
+	require.NoError(t, err)
+	require.Len(t, series, 3)
+
+	require.True(t, series[0].Month.Equal(date(time.January, 1)))
+	require.Equal(t, entity.Money{Amount: 800, Currency: "RUB"}, series[0].Money)
+
+	require.True(t, series[1].Month.Equal(date(time.February, 1)))
+	require.Equal(t, entity.Money{Amount: 0, Currency: ""}, series[1].Money)
+
+	require.True(t, series[2].Month.Equal(date(time.March, 1)))
+	require.Equal(t, entity.Money{Amount: 300, Currency: "RUB"}, series[2].Money)
+}
+
+func TestPostgres_GetForecast(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(2030, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	end := date(time.January, 31)
+	trialEnd := date(time.February, 28)
+
+	for _, s := range []entity.Subscription{
+		{ServiceName: "Adobe", Price: 1200, StartDate: date(time.January, 1), EndDate: &end},
+		{ServiceName: "Spotify", Price: 300, StartDate: date(time.January, 1), TrialEndDate: &trialEnd},
+	} {
+		s.Currency = entity.DefaultCurrency
+		s.BillingPeriod = entity.BillingPeriodMonthly
+		s.UserID = uid
+		_, err := repo.Create(context.Background(), &s)
+		require.NoError(t, err)
+	}
+
+	from, to := date(time.January, 1), date(time.March, 31)
+	sums, err := repo.GetForecast(context.Background(), filter.SumFilter{
+		UserID:    &uid,
+		StartDate: &from,
+		EndDate:   &to,
+	})
+	require.NoError(t, err)
+	require.Len(t, sums, 4)
+
+	require.True(t, sums[0].Month.Equal(date(time.January, 1)))
+	require.True(t, sums[0].Committed)
+	require.Equal(t, entity.Money{Amount: 1200, Currency: "RUB"}, sums[0].Money)
+
+	require.False(t, sums[1].Committed)
+	require.Equal(t, entity.Money{Amount: 0, Currency: "RUB"}, sums[1].Money)
+
+	require.True(t, sums[2].Month.Equal(date(time.February, 1)))
+	require.Equal(t, entity.Money{Amount: 0, Currency: "RUB"}, sums[2].Money)
+
+	require.True(t, sums[3].Month.Equal(date(time.March, 1)))
+	require.False(t, sums[3].Committed)
+	require.Equal(t, entity.Money{Amount: 300, Currency: "RUB"}, sums[3].Money)
+}
+
+func TestPostgres_Overlaps(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	create := func(service string, start time.Time, end *time.Time) int {
+		id, err := repo.Create(context.Background(), &entity.Subscription{
+			ServiceName:   service,
+			Price:         300,
+			Currency:      entity.DefaultCurrency,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			UserID:        uid,
+			StartDate:     start,
+			EndDate:       end,
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	endFeb, endJun := date(2025, time.February, 28), date(2024, time.June, 30)
+	first := create("Spotify", date(2025, time.January, 1), &endFeb)
+	second := create("Spotify", date(2025, time.February, 1), nil)
+	create("Spotify", date(2024, time.January, 1), &endJun)
+	create("Netflix", date(2025, time.January, 1), nil)
+
+	// a new open-ended subscription from March only meets the second one
+	id, err := repo.FindOverlap(context.Background(), &entity.Subscription{
+		ServiceName: "spotify",
+		UserID:      uid,
+		StartDate:   date(2025, time.March, 1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, second, id)
+
+	_, err = repo.FindOverlap(context.Background(), &entity.Subscription{
+		ServiceName: "Spotify",
+		UserID:      uuid.New(),
+		StartDate:   date(2025, time.March, 1),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	overlaps, err := repo.GetOverlaps(context.Background(), filter.DuplicateFilter{UserID: &uid, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, overlaps, 1)
+	require.Equal(t, first, overlaps[0].FirstID)
+	require.Equal(t, second, overlaps[0].SecondID)
+	require.Equal(t, date(2025, time.February, 1), overlaps[0].From)
+	require.Equal(t, endFeb, *overlaps[0].To)
+}
+
+func TestPostgres_Overlaps_Catalog(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+	services := db.NewServiceRepo(dbx)
+
+	newService := func(name string, aliases ...string) int {
+		id, err := services.Create(context.Background(), &entity.Service{Name: name, Aliases: aliases})
+		require.NoError(t, err)
+		return id
+	}
+	netflix := newService("Netflix", "Netflix Premium")
+	office := newService("Microsoft Office")
+	depot := newService("Office Depot")
+
+	uid := uuid.New()
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	create := func(serviceID int, name string) int {
+		id, err := repo.Create(context.Background(), &entity.Subscription{
+			ServiceID:     &serviceID,
+			ServiceName:   name,
+			Price:         300,
+			Currency:      entity.DefaultCurrency,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			UserID:        uid,
+			StartDate:     start,
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	// stored by an alias, still the same catalog entry
+	premium := create(netflix, "Netflix Premium")
+	create(office, "Office")
+
+	id, err := repo.FindOverlap(context.Background(), &entity.Subscription{
+		ServiceID:   &netflix,
+		ServiceName: "Netflix",
+		UserID:      uid,
+		StartDate:   start,
+	})
+	require.NoError(t, err)
+	require.Equal(t, premium, id)
+
+	// another catalog entry sharing the display name is another service
+	_, err = repo.FindOverlap(context.Background(), &entity.Subscription{
+		ServiceID:   &depot,
+		ServiceName: "Office",
+		UserID:      uid,
+		StartDate:   start,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	second := create(netflix, "Netflix")
+	create(depot, "Office")
+
+	overlaps, err := repo.GetOverlaps(context.Background(), filter.DuplicateFilter{UserID: &uid, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, overlaps, 1)
+	require.Equal(t, premium, overlaps[0].FirstID)
+	require.Equal(t, second, overlaps[0].SecondID)
+}
+
+func TestPostgres_Members(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	owner, half, fixed := uuid.New(), uuid.New(), uuid.New()
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	end := date(2025, time.January, 31)
+	percent, amount := 50.0, 100
+
+	id, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Family",
+		Price:         1000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        owner,
+		StartDate:     date(2025, time.January, 1),
+		EndDate:       &end,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.AddMember(context.Background(), entity.Member{
+		SubscriptionID: id, UserID: half, SharePercent: &percent,
+	}))
+	require.NoError(t, repo.AddMember(context.Background(), entity.Member{
+		SubscriptionID: id, UserID: fixed, ShareAmount: &amount,
+	}))
+
+	got, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, got.Members, 2)
+
+	total := func(uid *uuid.UUID) []entity.Money {
+		totals, err := repo.GetTotalSum(context.Background(), filter.SumFilter{UserID: uid})
+		require.NoError(t, err)
+		return totals
+	}
+	require.Equal(t, []entity.Money{{Amount: 400, Currency: "RUB"}}, total(&owner))
+	require.Equal(t, []entity.Money{{Amount: 500, Currency: "RUB"}}, total(&half))
+	require.Equal(t, []entity.Money{{Amount: 100, Currency: "RUB"}}, total(&fixed))
+	require.Equal(t, []entity.Money{{Amount: 1000, Currency: "RUB"}}, total(nil))
+
+	require.NoError(t, repo.RemoveMember(context.Background(), id, half))
+	require.ErrorIs(t, repo.RemoveMember(context.Background(), id, half), sql.ErrNoRows)
+	require.Equal(t, []entity.Money{{Amount: 900, Currency: "RUB"}}, total(&owner))
+
+	// fixed shares over the price are scaled down to add up to it
+	other, over := uuid.New(), 800
+	require.NoError(t, repo.AddMember(context.Background(), entity.Member{
+		SubscriptionID: id, UserID: fixed, ShareAmount: &over,
+	}))
+	require.NoError(t, repo.AddMember(context.Background(), entity.Member{
+		SubscriptionID: id, UserID: other, ShareAmount: &over,
+	}))
+	require.Equal(t, []entity.Money{{Amount: 0, Currency: "RUB"}}, total(&owner))
+	require.Equal(t, []entity.Money{{Amount: 500, Currency: "RUB"}}, total(&fixed))
+	require.Equal(t, []entity.Money{{Amount: 500, Currency: "RUB"}}, total(&other))
+	require.Equal(t, []entity.Money{{Amount: 1000, Currency: "RUB"}}, total(nil))
+}
+
+func TestPostgres_Chargeback(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+	centres := db.NewCostCentreRepo(dbx)
+
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	start, end := date(2025, time.March, 1), date(2025, time.March, 31)
+
+	marketing, err := centres.Create(context.Background(), &entity.CostCentre{Name: "Marketing"})
+	require.NoError(t, err)
+	sales, err := centres.Create(context.Background(), &entity.CostCentre{Name: "Sales"})
+	require.NoError(t, err)
+
+	split, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Figma",
+		Price:         1000,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uuid.New(),
+		StartDate:     start,
+	})
+	require.NoError(t, err)
+
+	whole, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Slack",
+		Price:         400,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uuid.New(),
+		StartDate:     start,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.SetAllocations(context.Background(), split, []entity.Allocation{
+		{SubscriptionID: split, CostCentreID: marketing, Percent: 60},
+		{SubscriptionID: split, CostCentreID: sales, Percent: 30},
+	}))
+	require.NoError(t, repo.SetAllocations(context.Background(), whole, []entity.Allocation{
+		{SubscriptionID: whole, CostCentreID: sales, Percent: 100},
+	}))
+	require.ErrorIs(t, repo.SetAllocations(context.Background(), 999, nil), sql.ErrNoRows)
+
+	got, err := repo.Get(context.Background(), split)
+	require.NoError(t, err)
+	require.Len(t, got.Allocations, 2)
+
+	rows, err := repo.GetChargeback(context.Background(), filter.SumFilter{StartDate: &start, EndDate: &end})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, "Marketing", *rows[0].CostCentreName)
+	require.Equal(t, 600, rows[0].Amount)
+	require.Equal(t, "Sales", *rows[1].CostCentreName)
+	require.Equal(t, 700, rows[1].Amount)
+	require.Nil(t, rows[2].CostCentreID)
+	require.Equal(t, 100, rows[2].Amount)
+
+	// replacing the allocations drops the ones left out
+	require.NoError(t, repo.SetAllocations(context.Background(), split, nil))
+
+	rows, err = repo.GetChargeback(context.Background(), filter.SumFilter{StartDate: &start, EndDate: &end})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, 400, rows[0].Amount)
+	require.Equal(t, 1000, rows[1].Amount)
+}
+
+func TestPostgres_Approvals(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid, approver := uuid.New(), uuid.New()
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	end := date(2025, time.March, 31)
+
+	id, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Notion",
+		Price:         800,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uid,
+		StartDate:     date(2025, time.January, 1),
+		EndDate:       &end,
+		Approval:      entity.ApprovalPending,
+	})
+	require.NoError(t, err)
+
+	got, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, entity.ApprovalPending, got.Approval)
+	require.Equal(t, entity.SubscriptionStatusPendingApproval, got.Status)
+	require.Nil(t, got.NextRenewal)
+
+	// pending spend is neither counted nor renewed
+	totals, err := repo.GetTotalSum(context.Background(), filter.SumFilter{UserID: &uid})
+	require.NoError(t, err)
+	require.Empty(t, totals)
+
+	renewals, err := repo.GetRenewals(context.Background(), filter.RenewalFilter{
+		UserID: &uid,
+		From:   date(2025, time.January, 1),
+		To:     end,
+	})
+	require.NoError(t, err)
+	require.Empty(t, renewals)
+
+	pending := true
+	list, err := repo.GetList(context.Background(), filter.ListFilter{Pending: &pending, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	comment := "Budgeted for Q1"
+	d := &entity.ApprovalDecision{
+		SubscriptionID: id,
+		Decision:       entity.ApprovalApproved,
+		DecidedBy:      approver,
+		Comment:        &comment,
+	}
+	require.NoError(t, repo.Decide(context.Background(), d))
+	require.True(t, d.ID > 0)
+	require.False(t, d.DecidedAt.IsZero())
+
+	// only a pending subscription can be decided on
+	require.ErrorIs(t, repo.Decide(context.Background(), &entity.ApprovalDecision{
+		SubscriptionID: id,
+		Decision:       entity.ApprovalRejected,
+		DecidedBy:      approver,
+	}), sql.ErrNoRows)
+
+	totals, err = repo.GetTotalSum(context.Background(), filter.SumFilter{UserID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 2400, Currency: "RUB"}}, totals)
+
+	decisions, err := repo.GetApprovals(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, decisions, 1)
+	require.Equal(t, entity.ApprovalApproved, decisions[0].Decision)
+	require.Equal(t, approver, decisions[0].DecidedBy)
+	require.Equal(t, comment, *decisions[0].Comment)
+}
+
+func TestPostgres_Contracts(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+	vendors := db.NewVendorRepo(dbx)
+
+	vendorID, err := vendors.Create(context.Background(), &entity.Vendor{Name: "Atlassian"})
+	require.NoError(t, err)
+
+	owner := uuid.New()
+	term, notice := 12, 30
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	id, err := repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:       "Jira",
+		Price:             1000,
+		Currency:          entity.DefaultCurrency,
+		BillingPeriod:     entity.BillingPeriodMonthly,
+		UserID:            uuid.New(),
+		StartDate:         start,
+		VendorID:          &vendorID,
+		MinimumTermMonths: &term,
+		NoticePeriodDays:  &notice,
+		RenewalOwner:      &owner,
+	})
+	require.NoError(t, err)
+
+	// without a notice period there is no deadline to track
+	_, err = repo.Create(context.Background(), &entity.Subscription{
+		ServiceName:   "Confluence",
+		Price:         500,
+		Currency:      entity.DefaultCurrency,
+		BillingPeriod: entity.BillingPeriodMonthly,
+		UserID:        uuid.New(),
+		StartDate:     start,
+		VendorID:      &vendorID,
+	})
+	require.NoError(t, err)
+
+	got, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, vendorID, *got.VendorID)
+	require.Equal(t, "Atlassian", *got.VendorName)
+	require.Equal(t, 12, *got.MinimumTermMonths)
+	require.Equal(t, owner, *got.RenewalOwner)
+
+	contracts, err := repo.GetContracts(context.Background(), filter.ContractFilter{RenewalOwner: &owner})
+	require.NoError(t, err)
+	require.Len(t, contracts, 1)
+	require.Equal(t, id, contracts[0].ID)
+
+	other := uuid.New()
+	contracts, err = repo.GetContracts(context.Background(), filter.ContractFilter{RenewalOwner: &other})
+	require.NoError(t, err)
+	require.Empty(t, contracts)
+
+	// a deleted vendor leaves its subscriptions without one
+	require.NoError(t, vendors.Delete(context.Background(), vendorID))
+
+	got, err = repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Nil(t, got.VendorID)
+	require.Nil(t, got.VendorName)
+}
+
+func TestPostgres_TerminationFee(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewSubscriptionRepo(dbx)
+
+	uid := uuid.New()
+	term := 12
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	sub := &entity.Subscription{
+		ServiceName:       "Jira",
+		Price:             1000,
+		Currency:          entity.DefaultCurrency,
+		BillingPeriod:     entity.BillingPeriodMonthly,
+		UserID:            uid,
+		StartDate:         start,
+		EndDate:           &end,
+		MinimumTermMonths: &term,
+	}
+	id, err := repo.Create(context.Background(), sub)
+	require.NoError(t, err)
+	sub.ID = id
+
+	fee := &entity.OneOffCharge{
+		UserID:      uid,
+		Date:        end,
+		Description: "Early termination fee: Jira",
+		Money:       entity.Money{Amount: 9000, Currency: entity.DefaultCurrency},
+	}
+	require.NoError(t, repo.UpdateContract(context.Background(), sub, fee))
+	require.NotZero(t, fee.ID)
+
+	// changing the contract again replaces the fee charged before
+	fee.Amount = 4500
+	require.NoError(t, repo.UpdateContract(context.Background(), sub, fee))
+
+	from, to := start, time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC)
+	sums, err := repo.GetMonthlySums(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Len(t, sums, 3)
+	require.Equal(t, 1000+4500, sums[2].Amount)
+
+	// another user's totals leave the fee out
+	other := uuid.New()
+	totals, err := repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &other, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Empty(t, totals)
+
+	// cancelling without a fee removes the one recorded before
+	cancelledAt := end
+	sub.CancelledAt = &cancelledAt
+	require.NoError(t, repo.Cancel(context.Background(), sub, nil))
+
+	totals, err = repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 3 * 1000, Currency: "RUB"}}, totals)
+
+	// a cancelled subscription keeps its fee untouched
+	require.ErrorIs(t, repo.Cancel(context.Background(), sub, fee), sql.ErrNoRows)
+
+	totals, err = repo.GetTotalSum(context.Background(),
+		filter.SumFilter{UserID: &uid, StartDate: &from, EndDate: &to},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Money{{Amount: 3 * 1000, Currency: "RUB"}}, totals)
 }
@@ -0,0 +1,147 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// vendorColumn selects the vendor name of subscriptions s.
+const vendorColumn = `(
+		SELECT v.name FROM vendors v WHERE v.id = s.vendor_id
+	) AS vendor_name`
+
+type VendorRepository struct {
+	db *sqlx.DB
+}
+
+func NewVendorRepo(db *sqlx.DB) *VendorRepository {
+	return &VendorRepository{
+		db: db,
+	}
+}
+
+func (r *VendorRepository) Create(ctx context.Context, v *entity.Vendor) (int, error) {
+	query := `
+		INSERT INTO vendors
+			(name, contact, account_manager, notes)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	var id int
+	err := r.db.QueryRowContext(ctx, query, v.Name, v.Contact, v.AccountManager, v.Notes).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create vendor using db: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *VendorRepository) Get(ctx context.Context, id int) (*entity.Vendor, error) {
+	query := `
+		SELECT id, name, contact, account_manager, notes
+		FROM vendors
+		WHERE id = $1
+	`
+
+	var v entity.Vendor
+
+	err := r.db.GetContext(ctx, &v, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get vendor using db: %w", err)
+	}
+
+	return &v, nil
+}
+
+func (r *VendorRepository) FindByName(ctx context.Context, name string) (*entity.Vendor, error) {
+	query := `
+		SELECT id, name, contact, account_manager, notes
+		FROM vendors
+		WHERE lower(name) = lower($1)
+	`
+
+	var v entity.Vendor
+
+	err := r.db.GetContext(ctx, &v, query, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to find vendor using db: %w", err)
+	}
+
+	return &v, nil
+}
+
+func (r *VendorRepository) Update(ctx context.Context, v *entity.Vendor) error {
+	query := `
+		UPDATE vendors
+		SET
+			name            = $1,
+			contact         = $2,
+			account_manager = $3,
+			notes           = $4
+		WHERE id = $5
+	`
+
+	res, err := r.db.ExecContext(ctx, query, v.Name, v.Contact, v.AccountManager, v.Notes, v.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update vendor using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Delete removes the vendor. Its subscriptions are kept without a vendor.
+func (r *VendorRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM vendors WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete vendor using db: %w", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count rows: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *VendorRepository) GetList(ctx context.Context, f filter.VendorFilter) ([]entity.Vendor, error) {
+	query := `
+		SELECT id, name, contact, account_manager, notes
+		FROM vendors
+		ORDER BY lower(name)
+		LIMIT $1 OFFSET $2
+	`
+
+	var vendors []entity.Vendor
+	if err := r.db.SelectContext(ctx, &vendors, query, f.Limit, f.Offset); err != nil {
+		return nil, fmt.Errorf("failed to get vendors using db: %w", err)
+	}
+
+	return vendors, nil
+}
@@ -0,0 +1,57 @@
+//go:build integration
+// +build integration
+
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/adapter/out/db"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_Vendors_CRUD(t *testing.T) {
+	dbx := setupDB(t)
+	repo := db.NewVendorRepo(dbx)
+
+	contact := "billing@atlassian.com"
+	id, err := repo.Create(context.Background(), &entity.Vendor{Name: "Atlassian", Contact: &contact})
+	require.NoError(t, err)
+	require.True(t, id > 0)
+
+	got, err := repo.FindByName(context.Background(), "ATLASSIAN")
+	require.NoError(t, err)
+	require.Equal(t, id, got.ID)
+	require.Equal(t, "billing@atlassian.com", *got.Contact)
+
+	_, err = repo.FindByName(context.Background(), "Adobe")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	manager := "Jane Roe"
+	got.AccountManager = &manager
+	got.Contact = nil
+	require.NoError(t, repo.Update(context.Background(), got))
+
+	got, err = repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, "Jane Roe", *got.AccountManager)
+	require.Nil(t, got.Contact)
+
+	_, err = repo.Create(context.Background(), &entity.Vendor{Name: "adobe"})
+	require.NoError(t, err)
+
+	all, err := repo.GetList(context.Background(), filter.VendorFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "adobe", all[0].Name)
+
+	require.NoError(t, repo.Delete(context.Background(), id))
+	require.ErrorIs(t, repo.Delete(context.Background(), id), sql.ErrNoRows)
+
+	_, err = repo.Get(context.Background(), id)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,35 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+)
+
+// LogNotifier reports exceeded budgets to the application log.
+type LogNotifier struct {
+	log *slog.Logger
+}
+
+func NewLogNotifier(log *slog.Logger) *LogNotifier {
+	return &LogNotifier{
+		log: log,
+	}
+}
+
+func (n *LogNotifier) BudgetExceeded(ctx context.Context, alert entity.BudgetAlert) {
+	attrs := []any{
+		slog.Int("budget_id", alert.Budget.ID),
+		slog.String("user_id", alert.Budget.UserID.String()),
+		slog.String("month", alert.Month.Format("01-2006")),
+		slog.Int("limit", alert.Budget.MonthlyLimit),
+		slog.Int("spent", alert.Spent.Amount),
+		slog.String("currency", string(alert.Spent.Currency)),
+	}
+	if alert.Budget.ServiceName != nil {
+		attrs = append(attrs, slog.String("service_name", *alert.Budget.ServiceName))
+	}
+
+	n.log.WarnContext(ctx, "budget exceeded", attrs...)
+}
@@ -0,0 +1,8 @@
+package dto
+
+type AddMember struct {
+	ID           int      `json:"id"`
+	UserID       string   `json:"user_id"`
+	SharePercent *float64 `json:"share_percent"`
+	ShareAmount  *int     `json:"share_amount"`
+}
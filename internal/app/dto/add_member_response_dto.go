@@ -0,0 +1,5 @@
+package dto
+
+type AddMemberResponse struct {
+	Added bool `json:"added"`
+}
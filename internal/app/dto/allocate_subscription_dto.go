@@ -0,0 +1,6 @@
+package dto
+
+type AllocateSubscription struct {
+	ID          int          `json:"id"`
+	Allocations []Allocation `json:"allocations"`
+}
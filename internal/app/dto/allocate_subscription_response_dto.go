@@ -0,0 +1,5 @@
+package dto
+
+type AllocateSubscriptionResponse struct {
+	Allocated bool `json:"allocated"`
+}
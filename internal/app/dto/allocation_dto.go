@@ -0,0 +1,7 @@
+package dto
+
+// Allocation charges a percentage of a subscription's spend to a cost centre.
+type Allocation struct {
+	CostCentreID int     `json:"cost_centre_id"`
+	Percent      float64 `json:"percent"`
+}
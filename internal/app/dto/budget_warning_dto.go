@@ -0,0 +1,12 @@
+package dto
+
+// BudgetWarning tells that a change to a subscription left its user over
+// budget this month.
+type BudgetWarning struct {
+	BudgetID    int     `json:"budget_id"`
+	ServiceName *string `json:"service_name,omitempty"`
+	Category    *string `json:"category,omitempty"`
+	Month       string  `json:"month"`
+	Limit       Money   `json:"limit"`
+	Spent       Money   `json:"spent"`
+}
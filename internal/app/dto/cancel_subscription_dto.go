@@ -0,0 +1,10 @@
+package dto
+
+// CancelSubscription ends a subscription; Confirm is set by the confirm query
+// flag and accepts an early termination fee.
+type CancelSubscription struct {
+	ID          int    `json:"id"`
+	Reason      string `json:"reason"`
+	AtPeriodEnd bool   `json:"at_period_end"`
+	Confirm     bool   `json:"-"`
+}
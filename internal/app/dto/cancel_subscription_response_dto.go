@@ -0,0 +1,11 @@
+package dto
+
+// CancelSubscriptionResponse carries the early termination fee of cancelling
+// a contract within its minimum term. Until the cancellation is confirmed it
+// is not applied, and ConfirmationRequired is set.
+type CancelSubscriptionResponse struct {
+	Cancelled            bool   `json:"cancelled"`
+	EndDate              string `json:"end_date,omitempty"`
+	TerminationFee       *Money `json:"termination_fee,omitempty"`
+	ConfirmationRequired bool   `json:"confirmation_required,omitempty"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type ChangeSeats struct {
+	ID            int     `json:"id"`
+	Seats         int     `json:"seats"`
+	EffectiveFrom *string `json:"effective_from"`
+}
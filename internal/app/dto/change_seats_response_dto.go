@@ -0,0 +1,6 @@
+package dto
+
+type ChangeSeatsResponse struct {
+	Changed  bool            `json:"changed"`
+	Warnings []BudgetWarning `json:"warnings,omitempty"`
+}
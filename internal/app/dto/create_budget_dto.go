@@ -0,0 +1,9 @@
+package dto
+
+type CreateBudget struct {
+	UserID       string  `json:"user_id"`
+	ServiceName  *string `json:"service_name"`
+	Category     *string `json:"category"`
+	MonthlyLimit int     `json:"monthly_limit"`
+	Currency     *string `json:"currency"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type CreateBudgetResponse struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type CreateCostCentre struct {
+	Name string  `json:"name"`
+	Code *string `json:"code"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type CreateCostCentreResponse struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+// CreateOneOffCharge records a charge paid once, linked to a subscription or
+// to a user alone. With a subscription, user_id and currency default to its
+// owner and currency.
+type CreateOneOffCharge struct {
+	SubscriptionID *int    `json:"subscription_id"`
+	UserID         *string `json:"user_id"`
+	Date           string  `json:"date"`
+	Amount         int     `json:"amount"`
+	Currency       *string `json:"currency"`
+	Description    string  `json:"description"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type CreateOneOffChargeResponse struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type CreateService struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type CreateServiceResponse struct {
+	ID int `json:"id"`
+}
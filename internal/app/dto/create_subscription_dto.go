@@ -1,9 +1,26 @@
 package dto
 
 type CreateSubscription struct {
-	ServiceName string  `json:"service_name"`
-	Price       int     `json:"price"`
-	UserID      string  `json:"user_id"`
-	StartDate   string  `json:"start_date"`
-	EndDate     *string `json:"end_date"`
+	ServiceID     *int     `json:"service_id"`
+	ServiceName   string   `json:"service_name"`
+	Category      *string  `json:"category"`
+	Tags          []string `json:"tags"`
+	Price         int      `json:"price"`
+	Seats         *int     `json:"seats"`
+	Currency      *string  `json:"currency"`
+	BillingPeriod *string  `json:"billing_period"`
+	UserID        string   `json:"user_id"`
+	StartDate     string   `json:"start_date"`
+	EndDate       *string  `json:"end_date"`
+	TrialEndDate  *string  `json:"trial_end_date"`
+	TrialPrice    *int     `json:"trial_price"`
+	VendorID      *int     `json:"vendor_id"`
+	// MinimumTermMonths and NoticePeriodDays make the subscription a
+	// contract whose notice deadline is tracked.
+	MinimumTermMonths *int    `json:"minimum_term_months"`
+	NoticePeriodDays  *int    `json:"notice_period_days"`
+	RenewalOwnerID    *string `json:"renewal_owner_id"`
+	// AllowOverlap lets the subscription overlap another one of the same
+	// user and service.
+	AllowOverlap bool `json:"allow_overlap"`
 }
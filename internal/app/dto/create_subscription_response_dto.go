@@ -1,5 +1,7 @@
 package dto
 
 type CreateSubscriptionResponse struct {
-	ID int `json:"id"`
+	ID              int             `json:"id"`
+	PendingApproval bool            `json:"pending_approval,omitempty"`
+	Warnings        []BudgetWarning `json:"warnings,omitempty"`
 }
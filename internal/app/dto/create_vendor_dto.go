@@ -0,0 +1,8 @@
+package dto
+
+type CreateVendor struct {
+	Name           string  `json:"name"`
+	Contact        *string `json:"contact"`
+	AccountManager *string `json:"account_manager"`
+	Notes          *string `json:"notes"`
+}
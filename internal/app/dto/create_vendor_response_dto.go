@@ -0,0 +1,5 @@
+package dto
+
+type CreateVendorResponse struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type DeleteBudget struct {
+	ID int `json:"id"`
+}
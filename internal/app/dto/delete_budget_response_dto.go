@@ -0,0 +1,5 @@
+package dto
+
+type DeleteBudgetResponse struct {
+	Deleted bool `json:"deleted"`
+}
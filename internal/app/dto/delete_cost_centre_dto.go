@@ -0,0 +1,5 @@
+package dto
+
+type DeleteCostCentre struct {
+	ID int `json:"id"`
+}
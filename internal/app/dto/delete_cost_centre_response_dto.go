@@ -0,0 +1,5 @@
+package dto
+
+type DeleteCostCentreResponse struct {
+	Deleted bool `json:"deleted"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type DeleteOneOffCharge struct {
+	ID int `json:"id"`
+}
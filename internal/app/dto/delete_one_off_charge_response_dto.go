@@ -0,0 +1,5 @@
+package dto
+
+type DeleteOneOffChargeResponse struct {
+	Deleted bool `json:"deleted"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type DeleteService struct {
+	ID int `json:"id"`
+}
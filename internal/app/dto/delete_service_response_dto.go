@@ -0,0 +1,5 @@
+package dto
+
+type DeleteServiceResponse struct {
+	Deleted bool `json:"deleted"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type DeleteVendor struct {
+	ID int `json:"id"`
+}
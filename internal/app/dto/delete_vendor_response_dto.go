@@ -0,0 +1,5 @@
+package dto
+
+type DeleteVendorResponse struct {
+	Deleted bool `json:"deleted"`
+}
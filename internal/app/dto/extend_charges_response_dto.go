@@ -0,0 +1,5 @@
+package dto
+
+type ExtendChargesResponse struct {
+	Added int `json:"added"`
+}
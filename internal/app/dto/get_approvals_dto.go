@@ -0,0 +1,5 @@
+package dto
+
+type GetApprovals struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+type ApprovalDecision struct {
+	Decision  string  `json:"decision"`
+	DecidedBy string  `json:"decided_by"`
+	Comment   *string `json:"comment"`
+	DecidedAt string  `json:"decided_at"`
+}
+
+type GetApprovalsResponse struct {
+	Items []ApprovalDecision `json:"items"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type GetBudget struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type GetBudgetListResponse struct {
+	Budgets []GetBudgetResponse `json:"budgets"`
+}
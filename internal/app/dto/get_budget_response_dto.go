@@ -0,0 +1,10 @@
+package dto
+
+type GetBudgetResponse struct {
+	ID           int     `json:"id"`
+	UserID       string  `json:"user_id"`
+	ServiceName  *string `json:"service_name,omitempty"`
+	Category     *string `json:"category,omitempty"`
+	MonthlyLimit int     `json:"monthly_limit"`
+	Currency     string  `json:"currency"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type GetBudgetStatus struct {
+	UserID string `json:"user_id" form:"user_id"`
+}
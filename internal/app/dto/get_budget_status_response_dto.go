@@ -0,0 +1,14 @@
+package dto
+
+// BudgetStatus is a budget set against the spend of the current month.
+type BudgetStatus struct {
+	Budget    GetBudgetResponse `json:"budget"`
+	Spent     Money             `json:"spent"`
+	Remaining Money             `json:"remaining"`
+	Exceeded  bool              `json:"exceeded"`
+}
+
+type GetBudgetStatusResponse struct {
+	Month string         `json:"month"`
+	Items []BudgetStatus `json:"items"`
+}
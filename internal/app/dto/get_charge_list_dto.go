@@ -0,0 +1,10 @@
+package dto
+
+type GetChargeList struct {
+	UserID         *string `json:"user_id" form:"user_id"`
+	SubscriptionID *int    `json:"subscription_id" form:"subscription_id"`
+	StartDate      *string `json:"start_date" form:"start_date"`
+	EndDate        *string `json:"end_date" form:"end_date"`
+	Limit          int     `json:"limit" form:"limit"`
+	Offset         int     `json:"offset" form:"offset"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+type Charge struct {
+	ID             int    `json:"id"`
+	SubscriptionID int    `json:"subscription_id"`
+	Date           string `json:"date"`
+	Amount         Money  `json:"amount"`
+}
+
+type GetChargeListResponse struct {
+	Items []Charge `json:"items"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type GetChargeback struct {
+	Month    *string `json:"month" form:"month"`
+	Currency *string `json:"currency" form:"currency"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+type GetChargebackResponse struct {
+	Month string           `json:"month"`
+	Items []ChargebackLine `json:"items"`
+}
+
+// ChargebackLine is the spend charged to one cost centre in the month. The
+// line without a cost centre holds the spend no allocation covers.
+type ChargebackLine struct {
+	CostCentreID   *int    `json:"cost_centre_id"`
+	CostCentreName *string `json:"cost_centre_name"`
+	Totals         []Money `json:"totals"`
+	Total          *Money  `json:"total,omitempty"`
+}
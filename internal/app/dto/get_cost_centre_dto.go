@@ -0,0 +1,5 @@
+package dto
+
+type GetCostCentre struct {
+	ID int `json:"id"`
+}
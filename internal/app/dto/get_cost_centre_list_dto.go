@@ -0,0 +1,6 @@
+package dto
+
+type GetCostCentreList struct {
+	Limit  int `json:"limit" form:"limit"`
+	Offset int `json:"offset" form:"offset"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type GetCostCentreListResponse struct {
+	CostCentres []GetCostCentreResponse `json:"cost_centres"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type GetCostCentreResponse struct {
+	ID   int     `json:"id"`
+	Name string  `json:"name"`
+	Code *string `json:"code"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type GetDuplicates struct {
+	UserID *string `json:"user_id" form:"user_id"`
+	Limit  int     `json:"limit" form:"limit"`
+	Offset int     `json:"offset" form:"offset"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+// Duplicate is a pair of subscriptions of one user to one service that are
+// both in effect from OverlapFrom until OverlapTo, or for good without it.
+type Duplicate struct {
+	UserID          string  `json:"user_id"`
+	ServiceName     string  `json:"service_name"`
+	SubscriptionIDs []int   `json:"subscription_ids"`
+	OverlapFrom     string  `json:"overlap_from"`
+	OverlapTo       *string `json:"overlap_to"`
+}
+
+type GetDuplicatesResponse struct {
+	Items []Duplicate `json:"items"`
+}
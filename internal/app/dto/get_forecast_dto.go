@@ -0,0 +1,7 @@
+package dto
+
+type GetForecast struct {
+	UserID   *string `json:"user_id" form:"user_id"`
+	Months   *int    `json:"months" form:"months"`
+	Currency *string `json:"currency" form:"currency"`
+}
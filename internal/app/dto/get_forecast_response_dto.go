@@ -0,0 +1,18 @@
+package dto
+
+// ForecastMonth is the spend expected in one month, formatted as MM-YYYY.
+// Committed spend comes from subscriptions with an end date, assumed spend
+// from open-ended ones expected to keep renewing.
+type ForecastMonth struct {
+	Month          string  `json:"month"`
+	Committed      []Money `json:"committed"`
+	Assumed        []Money `json:"assumed"`
+	CommittedTotal *Money  `json:"committed_total,omitempty"`
+	AssumedTotal   *Money  `json:"assumed_total,omitempty"`
+}
+
+type GetForecastResponse struct {
+	From   string          `json:"from"`
+	To     string          `json:"to"`
+	Months []ForecastMonth `json:"months"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type GetNoticeDeadlines struct {
+	Within         *string `json:"within" form:"within"`
+	RenewalOwnerID *string `json:"renewal_owner_id" form:"renewal_owner_id"`
+	VendorID       *int    `json:"vendor_id" form:"vendor_id"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+type NoticeDeadline struct {
+	SubscriptionID    int     `json:"subscription_id"`
+	ServiceName       string  `json:"service_name"`
+	VendorID          *int    `json:"vendor_id"`
+	VendorName        *string `json:"vendor_name"`
+	RenewalOwnerID    *string `json:"renewal_owner_id"`
+	MinimumTermMonths *int    `json:"minimum_term_months"`
+	NoticePeriodDays  int     `json:"notice_period_days"`
+	NoticeDeadline    string  `json:"notice_deadline"`
+	DaysLeft          int     `json:"days_left"`
+	RenewsOn          string  `json:"renews_on"`
+}
+
+type GetNoticeDeadlinesResponse struct {
+	From  string           `json:"from"`
+	To    string           `json:"to"`
+	Items []NoticeDeadline `json:"items"`
+}
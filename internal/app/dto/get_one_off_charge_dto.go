@@ -0,0 +1,5 @@
+package dto
+
+type GetOneOffCharge struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type GetOneOffChargeListResponse struct {
+	Charges []GetOneOffChargeResponse `json:"charges"`
+}
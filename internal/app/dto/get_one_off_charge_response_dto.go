@@ -0,0 +1,12 @@
+package dto
+
+type GetOneOffChargeResponse struct {
+	ID             int     `json:"id"`
+	SubscriptionID *int    `json:"subscription_id"`
+	ServiceName    *string `json:"service_name"`
+	UserID         string  `json:"user_id"`
+	Date           string  `json:"date"`
+	Amount         Money   `json:"amount"`
+	Description    string  `json:"description"`
+	TerminationFee bool    `json:"termination_fee"`
+}
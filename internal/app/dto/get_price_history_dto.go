@@ -0,0 +1,5 @@
+package dto
+
+type GetPriceHistory struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+type PricePeriod struct {
+	Price         int     `json:"price"`
+	Currency      string  `json:"currency"`
+	EffectiveFrom string  `json:"effective_from"`
+	EffectiveTo   *string `json:"effective_to"`
+}
+
+type GetPriceHistoryResponse struct {
+	Items []PricePeriod `json:"items"`
+}
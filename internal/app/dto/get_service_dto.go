@@ -0,0 +1,5 @@
+package dto
+
+type GetService struct {
+	ID int `json:"id"`
+}
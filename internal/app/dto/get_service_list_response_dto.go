@@ -0,0 +1,5 @@
+package dto
+
+type GetServiceListResponse struct {
+	Services []GetServiceResponse `json:"services"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type GetServiceResponse struct {
+	ID      int      `json:"id"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
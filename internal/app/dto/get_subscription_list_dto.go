@@ -1,8 +1,16 @@
 package dto
 
 type GetSubscriptionList struct {
-	UserID      *string `json:"user_id"`
-	ServiceName *string `json:"service_name"`
-	Limit       int     `json:"limit"`
-	Offset      int     `json:"offset"`
+	UserID         *string `json:"user_id" form:"user_id"`
+	ServiceName    *string `json:"service_name" form:"service_name"`
+	Category       *string `json:"category" form:"category"`
+	Tag            *string `json:"tag" form:"tag"`
+	Currency       *string `json:"currency" form:"currency"`
+	InTrial        *bool   `json:"in_trial" form:"in_trial"`
+	Cancelled      *bool   `json:"cancelled" form:"cancelled"`
+	Active         *bool   `json:"active" form:"active"`
+	ScheduledToEnd *bool   `json:"scheduled_to_end" form:"scheduled_to_end"`
+	Pending        *bool   `json:"pending_approval" form:"pending_approval"`
+	Limit          int     `json:"limit" form:"limit"`
+	Offset         int     `json:"offset" form:"offset"`
 }
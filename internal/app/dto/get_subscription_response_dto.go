@@ -1,10 +1,32 @@
 package dto
 
 type GetSubscriptionResponse struct {
-	ID          int     `json:"id"`
-	ServiceName string  `json:"service_name"`
-	Price       int     `json:"price"`
-	UserID      string  `json:"user_id"`
-	StartDate   string  `json:"start_date"`
-	EndDate     *string `json:"end_date"`
+	ID                 int          `json:"id"`
+	ServiceID          *int         `json:"service_id"`
+	ServiceName        string       `json:"service_name"`
+	Category           *string      `json:"category"`
+	Tags               []string     `json:"tags,omitempty"`
+	Members            []Member     `json:"members,omitempty"`
+	Allocations        []Allocation `json:"allocations,omitempty"`
+	Price              int          `json:"price"`
+	Seats              int          `json:"seats"`
+	EffectivePrice     int          `json:"effective_price"`
+	Currency           string       `json:"currency"`
+	BillingPeriod      string       `json:"billing_period"`
+	MonthlyPrice       int          `json:"monthly_price"`
+	UserID             string       `json:"user_id"`
+	StartDate          string       `json:"start_date"`
+	EndDate            *string      `json:"end_date"`
+	TrialEndDate       *string      `json:"trial_end_date"`
+	TrialPrice         int          `json:"trial_price"`
+	Status             string       `json:"status"`
+	PausedSince        *string      `json:"paused_since"`
+	NextRenewalDate    *string      `json:"next_renewal_date"`
+	CancelledAt        *string      `json:"cancelled_at"`
+	CancellationReason *string      `json:"cancellation_reason"`
+	VendorID           *int         `json:"vendor_id"`
+	VendorName         *string      `json:"vendor_name"`
+	MinimumTermMonths  *int         `json:"minimum_term_months"`
+	NoticePeriodDays   *int         `json:"notice_period_days"`
+	RenewalOwnerID     *string      `json:"renewal_owner_id"`
 }
@@ -0,0 +1,11 @@
+package dto
+
+type GetTimeseries struct {
+	UserID      *string `json:"user_id" form:"user_id"`
+	ServiceName *string `json:"service_name" form:"service_name"`
+	Category    *string `json:"category" form:"category"`
+	Tag         *string `json:"tag" form:"tag"`
+	From        *string `json:"from" form:"from"`
+	To          *string `json:"to" form:"to"`
+	Currency    *string `json:"currency" form:"currency"`
+}
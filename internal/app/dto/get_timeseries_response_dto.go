@@ -0,0 +1,12 @@
+package dto
+
+// TimeseriesPoint is the spend of one month, formatted as MM-YYYY.
+type TimeseriesPoint struct {
+	Month  string  `json:"month"`
+	Totals []Money `json:"totals"`
+	Total  *Money  `json:"total,omitempty"`
+}
+
+type GetTimeseriesResponse struct {
+	Points []TimeseriesPoint `json:"points"`
+}
@@ -1,8 +1,12 @@
 package dto
 
 type GetTotalSum struct {
-	UserID      *string `json:"user_id"`
-	ServiceName *string `json:"service_name"`
-	StartDate   *string `json:"start_date"`
-	EndDate     *string `json:"end_date"`
+	UserID      *string `json:"user_id" form:"user_id"`
+	ServiceName *string `json:"service_name" form:"service_name"`
+	Category    *string `json:"category" form:"category"`
+	Tag         *string `json:"tag" form:"tag"`
+	StartDate   *string `json:"start_date" form:"start_date"`
+	EndDate     *string `json:"end_date" form:"end_date"`
+	Currency    *string `json:"currency" form:"currency"`
+	GroupBy     *string `json:"group_by" form:"group_by"`
 }
@@ -1,5 +1,17 @@
 package dto
 
 type GetTotalSumResponse struct {
-	TotalSum int `json:"total_sum"`
+	Totals  []Money       `json:"totals"`
+	Total   *Money        `json:"total,omitempty"`
+	Buckets []TotalBucket `json:"buckets,omitempty"`
+}
+
+// TotalBucket holds the subtotals of one group of a breakdown; only the keys
+// the breakdown is grouped by are set. Month is formatted as MM-YYYY.
+type TotalBucket struct {
+	ServiceName *string `json:"service_name,omitempty"`
+	UserID      *string `json:"user_id,omitempty"`
+	Month       *string `json:"month,omitempty"`
+	Totals      []Money `json:"totals"`
+	Total       *Money  `json:"total,omitempty"`
 }
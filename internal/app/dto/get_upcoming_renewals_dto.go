@@ -0,0 +1,6 @@
+package dto
+
+type GetUpcomingRenewals struct {
+	UserID *string `json:"user_id" form:"user_id"`
+	Within *string `json:"within" form:"within"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+type UpcomingRenewal struct {
+	SubscriptionID int    `json:"subscription_id"`
+	ServiceName    string `json:"service_name"`
+	Date           string `json:"date"`
+	AmountDue      Money  `json:"amount_due"`
+}
+
+type GetUpcomingRenewalsResponse struct {
+	From  string            `json:"from"`
+	To    string            `json:"to"`
+	Items []UpcomingRenewal `json:"items"`
+}
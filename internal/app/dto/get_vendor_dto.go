@@ -0,0 +1,5 @@
+package dto
+
+type GetVendor struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type GetVendorListResponse struct {
+	Vendors []GetVendorResponse `json:"vendors"`
+}
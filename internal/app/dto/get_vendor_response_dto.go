@@ -0,0 +1,9 @@
+package dto
+
+type GetVendorResponse struct {
+	ID             int     `json:"id"`
+	Name           string  `json:"name"`
+	Contact        *string `json:"contact"`
+	AccountManager *string `json:"account_manager"`
+	Notes          *string `json:"notes"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "io"
+
+// ImportExchangeRates carries a CSV document with a "date,base,quote,rate"
+// record per line, dates formatted as DD-MM-YYYY. A header line is optional.
+type ImportExchangeRates struct {
+	Data io.Reader
+}
@@ -0,0 +1,5 @@
+package dto
+
+type ImportExchangeRatesResponse struct {
+	Imported int `json:"imported"`
+}
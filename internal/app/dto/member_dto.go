@@ -0,0 +1,9 @@
+package dto
+
+// Member is a user sharing a subscription, paying either a percentage of its
+// monthly price or a fixed monthly amount.
+type Member struct {
+	UserID       string   `json:"user_id"`
+	SharePercent *float64 `json:"share_percent,omitempty"`
+	ShareAmount  *int     `json:"share_amount,omitempty"`
+}
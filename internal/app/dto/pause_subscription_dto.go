@@ -0,0 +1,6 @@
+package dto
+
+type PauseSubscription struct {
+	ID   int     `json:"id"`
+	Date *string `json:"date"`
+}
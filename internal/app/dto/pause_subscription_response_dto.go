@@ -0,0 +1,5 @@
+package dto
+
+type PauseSubscriptionResponse struct {
+	Paused bool `json:"paused"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type RemoveMember struct {
+	ID     int    `json:"id"`
+	UserID string `json:"user_id"`
+}
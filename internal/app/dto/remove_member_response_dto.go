@@ -0,0 +1,5 @@
+package dto
+
+type RemoveMemberResponse struct {
+	Removed bool `json:"removed"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type ResumeSubscriptionResponse struct {
+	Resumed bool `json:"resumed"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+// ReviewSubscription approves or rejects a pending subscription; Approve is
+// set by the endpoint called.
+type ReviewSubscription struct {
+	ID         int     `json:"id"`
+	Approve    bool    `json:"-"`
+	ApproverID string  `json:"approver_id"`
+	Comment    *string `json:"comment"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type ReviewSubscriptionResponse struct {
+	Decision  string          `json:"decision"`
+	DecidedAt string          `json:"decided_at"`
+	Warnings  []BudgetWarning `json:"warnings,omitempty"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+type UpdateBudget struct {
+	ID           int     `json:"id"`
+	UserID       *string `json:"user_id"`
+	ServiceName  *string `json:"service_name"`
+	Category     *string `json:"category"`
+	MonthlyLimit *int    `json:"monthly_limit"`
+	Currency     *string `json:"currency"`
+}
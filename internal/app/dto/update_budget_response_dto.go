@@ -0,0 +1,5 @@
+package dto
+
+type UpdateBudgetResponse struct {
+	Updated bool `json:"updated"`
+}
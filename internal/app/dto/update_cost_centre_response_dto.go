@@ -0,0 +1,5 @@
+package dto
+
+type UpdateCostCentreResponse struct {
+	Updated bool `json:"updated"`
+}
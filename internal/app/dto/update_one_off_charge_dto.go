@@ -0,0 +1,13 @@
+package dto
+
+// UpdateOneOffCharge changes the given fields only; a subscription_id of 0
+// leaves the charge to its user alone.
+type UpdateOneOffCharge struct {
+	ID             int     `json:"id"`
+	SubscriptionID *int    `json:"subscription_id"`
+	UserID         *string `json:"user_id"`
+	Date           *string `json:"date"`
+	Amount         *int    `json:"amount"`
+	Currency       *string `json:"currency"`
+	Description    *string `json:"description"`
+}
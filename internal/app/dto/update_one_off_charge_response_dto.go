@@ -0,0 +1,5 @@
+package dto
+
+type UpdateOneOffChargeResponse struct {
+	Updated bool `json:"updated"`
+}
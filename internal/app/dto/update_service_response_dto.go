@@ -0,0 +1,5 @@
+package dto
+
+type UpdateServiceResponse struct {
+	Updated bool `json:"updated"`
+}
@@ -1,10 +1,27 @@
 package dto
 
+// UpdateSubscription changes the given fields only; Confirm is set by the
+// confirm query flag and accepts an early termination fee.
 type UpdateSubscription struct {
-	ID          int     `json:"id"`
-	ServiceName *string `json:"service_name"`
-	Price       *int    `json:"price"`
-	UserID      *string `json:"user_id"`
-	StartDate   *string `json:"start_date"`
-	EndDate     *string `json:"end_date"`
+	ID                 int       `json:"id"`
+	ServiceID          *int      `json:"service_id"`
+	ServiceName        *string   `json:"service_name"`
+	Category           *string   `json:"category"`
+	Tags               *[]string `json:"tags"`
+	Price              *int      `json:"price"`
+	PriceEffectiveFrom *string   `json:"price_effective_from"`
+	Currency           *string   `json:"currency"`
+	BillingPeriod      *string   `json:"billing_period"`
+	UserID             *string   `json:"user_id"`
+	StartDate          *string   `json:"start_date"`
+	EndDate            *string   `json:"end_date"`
+	TrialEndDate       *string   `json:"trial_end_date"`
+	TrialPrice         *int      `json:"trial_price"`
+	// 0 clears vendor_id, minimum_term_months and notice_period_days, an
+	// empty string clears renewal_owner_id.
+	VendorID          *int    `json:"vendor_id"`
+	MinimumTermMonths *int    `json:"minimum_term_months"`
+	NoticePeriodDays  *int    `json:"notice_period_days"`
+	RenewalOwnerID    *string `json:"renewal_owner_id"`
+	Confirm           bool    `json:"-"`
 }
@@ -1,5 +1,11 @@
 package dto
 
+// UpdateSubscriptionResponse carries the early termination fee of a change
+// ending a contract within its minimum term. Until the change is confirmed it
+// is not applied, and ConfirmationRequired is set.
 type UpdateSubscriptionResponse struct {
-	Updated bool `json:"updated"`
+	Updated              bool            `json:"updated"`
+	Warnings             []BudgetWarning `json:"warnings,omitempty"`
+	TerminationFee       *Money          `json:"termination_fee,omitempty"`
+	ConfirmationRequired bool            `json:"confirmation_required,omitempty"`
 }
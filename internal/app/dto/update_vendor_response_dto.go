@@ -0,0 +1,5 @@
+package dto
+
+type UpdateVendorResponse struct {
+	Updated bool `json:"updated"`
+}
@@ -1,6 +1,8 @@
 package mappers
 
 import (
+	"time"
+
 	"github.com/maket12/SubTrack/internal/app/dto"
 	"github.com/maket12/SubTrack/internal/domain/entity"
 )
@@ -12,13 +14,82 @@ func MapIntoGetSubscriptionDTO(sub *entity.Subscription) dto.GetSubscriptionResp
 		end = &formatted
 	}
 
+	var trialEnd *string
+	if sub.TrialEndDate != nil {
+		formatted := sub.TrialEndDate.Format("02-01-2006")
+		trialEnd = &formatted
+	}
+
+	var pausedSince *string
+	if sub.PausedAt != nil {
+		formatted := sub.PausedAt.Format("02-01-2006")
+		pausedSince = &formatted
+	}
+
+	var nextRenewal *string
+	if sub.NextRenewal != nil {
+		formatted := sub.NextRenewal.Format("02-01-2006")
+		nextRenewal = &formatted
+	}
+
+	var cancelledAt *string
+	if sub.CancelledAt != nil {
+		formatted := sub.CancelledAt.UTC().Format(time.RFC3339)
+		cancelledAt = &formatted
+	}
+
+	var renewalOwner *string
+	if sub.RenewalOwner != nil {
+		formatted := sub.RenewalOwner.String()
+		renewalOwner = &formatted
+	}
+
+	var members []dto.Member
+	for _, m := range sub.Members {
+		members = append(members, dto.Member{
+			UserID:       m.UserID.String(),
+			SharePercent: m.SharePercent,
+			ShareAmount:  m.ShareAmount,
+		})
+	}
+
+	var allocations []dto.Allocation
+	for _, a := range sub.Allocations {
+		allocations = append(allocations, dto.Allocation{
+			CostCentreID: a.CostCentreID,
+			Percent:      a.Percent,
+		})
+	}
+
 	return dto.GetSubscriptionResponse{
-		ID:          sub.ID,
-		ServiceName: sub.ServiceName,
-		Price:       sub.Price,
-		UserID:      sub.UserID.String(),
-		StartDate:   sub.StartDate.Format("02-01-2006"),
-		EndDate:     end,
+		ID:                 sub.ID,
+		ServiceID:          sub.ServiceID,
+		ServiceName:        sub.ServiceName,
+		Category:           sub.Category,
+		Tags:               sub.Tags,
+		Members:            members,
+		Allocations:        allocations,
+		Price:              sub.Price,
+		Seats:              sub.Seats,
+		EffectivePrice:     sub.EffectivePrice(),
+		Currency:           string(sub.Currency),
+		BillingPeriod:      string(sub.BillingPeriod),
+		MonthlyPrice:       sub.MonthlyPrice(),
+		UserID:             sub.UserID.String(),
+		StartDate:          sub.StartDate.Format("02-01-2006"),
+		EndDate:            end,
+		TrialEndDate:       trialEnd,
+		TrialPrice:         sub.TrialPrice,
+		Status:             string(sub.Status),
+		PausedSince:        pausedSince,
+		NextRenewalDate:    nextRenewal,
+		CancelledAt:        cancelledAt,
+		CancellationReason: sub.CancellationReason,
+		VendorID:           sub.VendorID,
+		VendorName:         sub.VendorName,
+		MinimumTermMonths:  sub.MinimumTermMonths,
+		NoticePeriodDays:   sub.NoticePeriodDays,
+		RenewalOwnerID:     renewalOwner,
 	}
 }
 
@@ -34,3 +105,303 @@ func MapIntoGetSubscriptionListDTO(subs []entity.Subscription) dto.GetSubscripti
 		Items: items,
 	}
 }
+
+func MapIntoMoneyDTOs(amounts []entity.Money) []dto.Money {
+	items := make([]dto.Money, 0, len(amounts))
+
+	for _, m := range amounts {
+		items = append(items, dto.Money{
+			Amount:   m.Amount,
+			Currency: string(m.Currency),
+		})
+	}
+
+	return items
+}
+
+// MapIntoPriceHistoryDTO expects periods ordered by EffectiveFrom; each period
+// ends the day before the next one starts.
+func MapIntoPriceHistoryDTO(sub *entity.Subscription, periods []entity.PricePeriod) dto.GetPriceHistoryResponse {
+	items := make([]dto.PricePeriod, 0, len(periods))
+
+	for i, p := range periods {
+		var to *string
+		if i+1 < len(periods) {
+			formatted := periods[i+1].EffectiveFrom.AddDate(0, 0, -1).Format("02-01-2006")
+			to = &formatted
+		}
+
+		items = append(items, dto.PricePeriod{
+			Price:         p.Price,
+			Currency:      string(sub.Currency),
+			EffectiveFrom: p.EffectiveFrom.Format("02-01-2006"),
+			EffectiveTo:   to,
+		})
+	}
+
+	return dto.GetPriceHistoryResponse{
+		Items: items,
+	}
+}
+
+// MapIntoTerminationFeeDTO maps the amount of an early termination fee; no
+// fee maps to nil.
+func MapIntoTerminationFeeDTO(fee *entity.OneOffCharge) *dto.Money {
+	if fee == nil {
+		return nil
+	}
+
+	return &dto.Money{
+		Amount:   fee.Amount,
+		Currency: string(fee.Currency),
+	}
+}
+
+func MapIntoUpcomingRenewalsDTO(from, to time.Time, renewals []entity.Renewal) dto.GetUpcomingRenewalsResponse {
+	items := make([]dto.UpcomingRenewal, 0, len(renewals))
+
+	for _, r := range renewals {
+		items = append(items, dto.UpcomingRenewal{
+			SubscriptionID: r.SubscriptionID,
+			ServiceName:    r.ServiceName,
+			Date:           r.Date.Format("02-01-2006"),
+			AmountDue: dto.Money{
+				Amount:   r.Amount,
+				Currency: string(r.Currency),
+			},
+		})
+	}
+
+	return dto.GetUpcomingRenewalsResponse{
+		From:  from.Format("02-01-2006"),
+		To:    to.Format("02-01-2006"),
+		Items: items,
+	}
+}
+
+func MapIntoNoticeDeadlinesDTO(from, to time.Time, deadlines []entity.NoticeDeadline) dto.GetNoticeDeadlinesResponse {
+	items := make([]dto.NoticeDeadline, 0, len(deadlines))
+
+	for _, d := range deadlines {
+		var renewalOwner *string
+		if d.RenewalOwner != nil {
+			formatted := d.RenewalOwner.String()
+			renewalOwner = &formatted
+		}
+
+		items = append(items, dto.NoticeDeadline{
+			SubscriptionID:    d.ID,
+			ServiceName:       d.ServiceName,
+			VendorID:          d.VendorID,
+			VendorName:        d.VendorName,
+			RenewalOwnerID:    renewalOwner,
+			MinimumTermMonths: d.MinimumTermMonths,
+			NoticePeriodDays:  *d.NoticePeriodDays,
+			NoticeDeadline:    d.Deadline.Format("02-01-2006"),
+			DaysLeft:          int(d.Deadline.Sub(from).Hours() / 24),
+			RenewsOn:          d.RenewsOn.Format("02-01-2006"),
+		})
+	}
+
+	return dto.GetNoticeDeadlinesResponse{
+		From:  from.Format("02-01-2006"),
+		To:    to.Format("02-01-2006"),
+		Items: items,
+	}
+}
+
+func MapIntoChargeListDTO(charges []entity.Charge) dto.GetChargeListResponse {
+	items := make([]dto.Charge, 0, len(charges))
+
+	for _, c := range charges {
+		items = append(items, dto.Charge{
+			ID:             c.ID,
+			SubscriptionID: c.SubscriptionID,
+			Date:           c.Date.Format("02-01-2006"),
+			Amount: dto.Money{
+				Amount:   c.Amount,
+				Currency: string(c.Currency),
+			},
+		})
+	}
+
+	return dto.GetChargeListResponse{
+		Items: items,
+	}
+}
+
+func MapIntoGetBudgetDTO(b *entity.Budget) dto.GetBudgetResponse {
+	return dto.GetBudgetResponse{
+		ID:           b.ID,
+		UserID:       b.UserID.String(),
+		ServiceName:  b.ServiceName,
+		Category:     b.Category,
+		MonthlyLimit: b.MonthlyLimit,
+		Currency:     string(b.Currency),
+	}
+}
+
+func MapIntoGetBudgetListDTO(budgets []entity.Budget) dto.GetBudgetListResponse {
+	items := make([]dto.GetBudgetResponse, 0, len(budgets))
+
+	for _, b := range budgets {
+		items = append(items, MapIntoGetBudgetDTO(&b))
+	}
+
+	return dto.GetBudgetListResponse{
+		Budgets: items,
+	}
+}
+
+func MapIntoBudgetWarningDTO(alert entity.BudgetAlert) dto.BudgetWarning {
+	return dto.BudgetWarning{
+		BudgetID:    alert.Budget.ID,
+		ServiceName: alert.Budget.ServiceName,
+		Category:    alert.Budget.Category,
+		Month:       alert.Month.Format("01-2006"),
+		Limit: dto.Money{
+			Amount:   alert.Budget.MonthlyLimit,
+			Currency: string(alert.Budget.Currency),
+		},
+		Spent: dto.Money{
+			Amount:   alert.Spent.Amount,
+			Currency: string(alert.Spent.Currency),
+		},
+	}
+}
+
+func MapIntoGetServiceDTO(s *entity.Service) dto.GetServiceResponse {
+	aliases := s.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	return dto.GetServiceResponse{
+		ID:      s.ID,
+		Name:    s.Name,
+		Aliases: aliases,
+	}
+}
+
+func MapIntoGetServiceListDTO(services []entity.Service) dto.GetServiceListResponse {
+	items := make([]dto.GetServiceResponse, 0, len(services))
+
+	for _, s := range services {
+		items = append(items, MapIntoGetServiceDTO(&s))
+	}
+
+	return dto.GetServiceListResponse{
+		Services: items,
+	}
+}
+
+func MapIntoGetDuplicatesDTO(overlaps []entity.Overlap) dto.GetDuplicatesResponse {
+	items := make([]dto.Duplicate, 0, len(overlaps))
+
+	for _, o := range overlaps {
+		var to *string
+		if o.To != nil {
+			formatted := o.To.Format("02-01-2006")
+			to = &formatted
+		}
+
+		items = append(items, dto.Duplicate{
+			UserID:          o.UserID.String(),
+			ServiceName:     o.ServiceName,
+			SubscriptionIDs: []int{o.FirstID, o.SecondID},
+			OverlapFrom:     o.From.Format("02-01-2006"),
+			OverlapTo:       to,
+		})
+	}
+
+	return dto.GetDuplicatesResponse{
+		Items: items,
+	}
+}
+
+func MapIntoGetCostCentreDTO(c *entity.CostCentre) dto.GetCostCentreResponse {
+	return dto.GetCostCentreResponse{
+		ID:   c.ID,
+		Name: c.Name,
+		Code: c.Code,
+	}
+}
+
+func MapIntoGetCostCentreListDTO(centres []entity.CostCentre) dto.GetCostCentreListResponse {
+	items := make([]dto.GetCostCentreResponse, 0, len(centres))
+
+	for _, c := range centres {
+		items = append(items, MapIntoGetCostCentreDTO(&c))
+	}
+
+	return dto.GetCostCentreListResponse{
+		CostCentres: items,
+	}
+}
+
+func MapIntoGetVendorDTO(v *entity.Vendor) dto.GetVendorResponse {
+	return dto.GetVendorResponse{
+		ID:             v.ID,
+		Name:           v.Name,
+		Contact:        v.Contact,
+		AccountManager: v.AccountManager,
+		Notes:          v.Notes,
+	}
+}
+
+func MapIntoGetVendorListDTO(vendors []entity.Vendor) dto.GetVendorListResponse {
+	items := make([]dto.GetVendorResponse, 0, len(vendors))
+
+	for _, v := range vendors {
+		items = append(items, MapIntoGetVendorDTO(&v))
+	}
+
+	return dto.GetVendorListResponse{
+		Vendors: items,
+	}
+}
+
+func MapIntoGetOneOffChargeDTO(c *entity.OneOffCharge) dto.GetOneOffChargeResponse {
+	return dto.GetOneOffChargeResponse{
+		ID:             c.ID,
+		SubscriptionID: c.SubscriptionID,
+		ServiceName:    c.ServiceName,
+		UserID:         c.UserID.String(),
+		Date:           c.Date.Format("02-01-2006"),
+		Amount: dto.Money{
+			Amount:   c.Amount,
+			Currency: string(c.Currency),
+		},
+		Description:    c.Description,
+		TerminationFee: c.TerminationFee,
+	}
+}
+
+func MapIntoGetOneOffChargeListDTO(charges []entity.OneOffCharge) dto.GetOneOffChargeListResponse {
+	items := make([]dto.GetOneOffChargeResponse, 0, len(charges))
+
+	for _, c := range charges {
+		items = append(items, MapIntoGetOneOffChargeDTO(&c))
+	}
+
+	return dto.GetOneOffChargeListResponse{
+		Charges: items,
+	}
+}
+
+func MapIntoGetApprovalsDTO(decisions []entity.ApprovalDecision) dto.GetApprovalsResponse {
+	items := make([]dto.ApprovalDecision, 0, len(decisions))
+
+	for _, d := range decisions {
+		items = append(items, dto.ApprovalDecision{
+			Decision:  string(d.Decision),
+			DecidedBy: d.DecidedBy.String(),
+			Comment:   d.Comment,
+			DecidedAt: d.DecidedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return dto.GetApprovalsResponse{
+		Items: items,
+	}
+}
@@ -0,0 +1,14 @@
+package uc_errors
+
+// OverlapError is ErrSubscriptionOverlap naming the subscription overlapped.
+type OverlapError struct {
+	ConflictingID int
+}
+
+func (e *OverlapError) Error() string {
+	return ErrSubscriptionOverlap.Error()
+}
+
+func (e *OverlapError) Unwrap() error {
+	return ErrSubscriptionOverlap
+}
@@ -3,19 +3,117 @@ package uc_errors
 import "errors"
 
 var (
-	ErrEmptyServiceName      = errors.New("empty service name")
-	ErrInvalidDate           = errors.New("bad date format, expected DD-MM-YYYY")
-	ErrEmptyDate             = errors.New("empty date")
-	ErrEmptyUserID           = errors.New("empty user_id")
-	ErrInvalidUserID         = errors.New("user_id is not a valid UUID")
-	ErrCreateSubscription    = errors.New("failed to create subscription")
-	ErrInvalidSubscriptionID = errors.New("id must be positive")
-	ErrGetSubscription       = errors.New("failed to get subscription")
-	ErrSubscriptionNotFound  = errors.New("subscription not found")
-	ErrUpdateSubscription    = errors.New("failed to update subscription")
-	ErrDeleteSubscription    = errors.New("failed to delete subscription")
-	ErrInvalidLimit          = errors.New("limit must be positive or 0")
-	ErrInvalidOffset         = errors.New("offset must be positive or 0")
-	ErrGetSubscriptionList   = errors.New("failed to get subscription list")
-	ErrGetTotalSum           = errors.New("failed to get total sum")
+	ErrEmptyServiceName        = errors.New("empty service name")
+	ErrInvalidDate             = errors.New("bad date format, expected DD-MM-YYYY")
+	ErrEmptyDate               = errors.New("empty date")
+	ErrEmptyUserID             = errors.New("empty user_id")
+	ErrInvalidUserID           = errors.New("user_id is not a valid UUID")
+	ErrCreateSubscription      = errors.New("failed to create subscription")
+	ErrInvalidSubscriptionID   = errors.New("id must be positive")
+	ErrGetSubscription         = errors.New("failed to get subscription")
+	ErrSubscriptionNotFound    = errors.New("subscription not found")
+	ErrUpdateSubscription      = errors.New("failed to update subscription")
+	ErrDeleteSubscription      = errors.New("failed to delete subscription")
+	ErrInvalidLimit            = errors.New("limit must be positive or 0")
+	ErrInvalidOffset           = errors.New("offset must be positive or 0")
+	ErrGetSubscriptionList     = errors.New("failed to get subscription list")
+	ErrGetTotalSum             = errors.New("failed to get total sum")
+	ErrInvalidPeriod           = errors.New("end_date must not be before start_date")
+	ErrInvalidBillingPeriod    = errors.New("billing_period must be one of weekly, monthly, quarterly, yearly")
+	ErrInvalidCurrency         = errors.New("currency must be a valid ISO 4217 code")
+	ErrExchangeRateNotFound    = errors.New("exchange rate not found")
+	ErrGetExchangeRate         = errors.New("failed to get exchange rate")
+	ErrInvalidExchangeRates    = errors.New("invalid exchange rates csv")
+	ErrEmptyExchangeRates      = errors.New("no exchange rates to import")
+	ErrSaveExchangeRates       = errors.New("failed to save exchange rates")
+	ErrGetPriceHistory         = errors.New("failed to get price history")
+	ErrSubscriptionPaused      = errors.New("subscription is already paused")
+	ErrSubscriptionNotPaused   = errors.New("subscription is not paused")
+	ErrInvalidPauseDate        = errors.New("pause date must fall within the subscription period")
+	ErrInvalidResumeDate       = errors.New("resume date must not be before the pause started")
+	ErrPauseOverlap            = errors.New("pause date falls within an earlier pause")
+	ErrPauseSubscription       = errors.New("failed to pause subscription")
+	ErrResumeSubscription      = errors.New("failed to resume subscription")
+	ErrEmptyCancellationReason = errors.New("empty cancellation reason")
+	ErrSubscriptionCancelled   = errors.New("subscription is already cancelled")
+	ErrSubscriptionEnded       = errors.New("subscription has already ended")
+	ErrCancelSubscription      = errors.New("failed to cancel subscription")
+	ErrInvalidWithin           = errors.New("within must be a number of days or weeks up to a year, like 30d or 4w")
+	ErrGetUpcomingRenewals     = errors.New("failed to get upcoming renewals")
+	ErrExtendCharges           = errors.New("failed to extend charges ledger")
+	ErrGetCharges              = errors.New("failed to get charges")
+	ErrInvalidGroupBy          = errors.New("group_by must list service_name, user_id or month")
+	ErrGetTimeseries           = errors.New("failed to get timeseries")
+	ErrInvalidMonths           = errors.New("months must be between 1 and 60")
+	ErrTimeseriesTooLong       = errors.New("from and to must be at most 120 months apart")
+	ErrGetForecast             = errors.New("failed to get forecast")
+	ErrInvalidBudgetID         = errors.New("budget id must be positive")
+	ErrInvalidMonthlyLimit     = errors.New("monthly_limit must not be negative")
+	ErrBudgetNotFound          = errors.New("budget not found")
+	ErrCreateBudget            = errors.New("failed to create budget")
+	ErrGetBudget               = errors.New("failed to get budget")
+	ErrUpdateBudget            = errors.New("failed to update budget")
+	ErrDeleteBudget            = errors.New("failed to delete budget")
+	ErrGetBudgetList           = errors.New("failed to get budget list")
+	ErrCheckBudgets            = errors.New("failed to check budgets")
+	ErrInvalidServiceID        = errors.New("service id must be positive")
+	ErrEmptyServiceAlias       = errors.New("empty service alias")
+	ErrServiceNotFound         = errors.New("service not found")
+	ErrServiceNameTaken        = errors.New("service name or alias is already in the catalog")
+	ErrCreateService           = errors.New("failed to create service")
+	ErrGetService              = errors.New("failed to get service")
+	ErrUpdateService           = errors.New("failed to update service")
+	ErrDeleteService           = errors.New("failed to delete service")
+	ErrGetServiceList          = errors.New("failed to get service list")
+	ErrEmptyTag                = errors.New("empty tag")
+	ErrSubscriptionOverlap     = errors.New("subscription overlaps with another one of the same user and service")
+	ErrCheckOverlap            = errors.New("failed to check for overlapping subscriptions")
+	ErrGetDuplicates           = errors.New("failed to get duplicate subscriptions")
+	ErrInvalidShare            = errors.New("set either share_percent above 0 and up to 100, or share_amount of 0 or more")
+	ErrMemberIsOwner           = errors.New("the owner of a subscription cannot be one of its members")
+	ErrSharesOverTotal         = errors.New("member shares would add up to more than the monthly price")
+	ErrMemberNotFound          = errors.New("member not found")
+	ErrAddMember               = errors.New("failed to add subscription member")
+	ErrRemoveMember            = errors.New("failed to remove subscription member")
+	ErrChangeSeats             = errors.New("failed to change seats")
+	ErrInvalidCostCentreID     = errors.New("cost centre id must be positive")
+	ErrEmptyCostCentreName     = errors.New("empty cost centre name")
+	ErrCostCentreNotFound      = errors.New("cost centre not found")
+	ErrCostCentreNameTaken     = errors.New("cost centre name is already taken")
+	ErrCreateCostCentre        = errors.New("failed to create cost centre")
+	ErrGetCostCentre           = errors.New("failed to get cost centre")
+	ErrUpdateCostCentre        = errors.New("failed to update cost centre")
+	ErrDeleteCostCentre        = errors.New("failed to delete cost centre")
+	ErrGetCostCentreList       = errors.New("failed to get cost centre list")
+	ErrInvalidAllocation       = errors.New("every allocation needs a cost centre, listed once, and a percent above 0 and up to 100")
+	ErrAllocationsOverTotal    = errors.New("allocations add up to more than 100 percent")
+	ErrAllocateSubscription    = errors.New("failed to allocate subscription")
+	ErrInvalidMonth            = errors.New("month must be formatted as MM-YYYY")
+	ErrGetChargeback           = errors.New("failed to get chargeback")
+	ErrEmptyApproverID         = errors.New("empty approver id")
+	ErrInvalidApproverID       = errors.New("approver_id must be a valid uuid")
+	ErrEmptyRejectionComment   = errors.New("a rejection needs a comment")
+	ErrSubscriptionNotPending  = errors.New("subscription is not pending approval")
+	ErrReviewSubscription      = errors.New("failed to record approval decision")
+	ErrGetApprovals            = errors.New("failed to get approval decisions")
+	ErrInvalidVendorID         = errors.New("vendor id must be positive")
+	ErrEmptyVendorName         = errors.New("empty vendor name")
+	ErrVendorNotFound          = errors.New("vendor not found")
+	ErrVendorNameTaken         = errors.New("vendor name is already taken")
+	ErrCreateVendor            = errors.New("failed to create vendor")
+	ErrGetVendor               = errors.New("failed to get vendor")
+	ErrUpdateVendor            = errors.New("failed to update vendor")
+	ErrDeleteVendor            = errors.New("failed to delete vendor")
+	ErrGetVendorList           = errors.New("failed to get vendor list")
+	ErrInvalidRenewalOwnerID   = errors.New("renewal_owner_id must be a valid uuid")
+	ErrGetNoticeDeadlines      = errors.New("failed to get notice deadlines")
+	ErrInvalidOneOffChargeID   = errors.New("one-off charge id must be positive")
+	ErrNoChargeOwner           = errors.New("a one-off charge needs a subscription_id or a user_id")
+	ErrOneOffChargeNotFound    = errors.New("one-off charge not found")
+	ErrTerminationFeeCharge    = errors.New("an early termination fee only changes with the contract of its subscription")
+	ErrCreateOneOffCharge      = errors.New("failed to create one-off charge")
+	ErrGetOneOffCharge         = errors.New("failed to get one-off charge")
+	ErrUpdateOneOffCharge      = errors.New("failed to update one-off charge")
+	ErrDeleteOneOffCharge      = errors.New("failed to delete one-off charge")
+	ErrGetOneOffChargeList     = errors.New("failed to get one-off charge list")
 )
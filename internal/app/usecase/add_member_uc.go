@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type AddMemberUC struct {
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *AddMemberUC) Execute(ctx context.Context, in dto.AddMember) (dto.AddMemberResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.AddMemberResponse{Added: false}, uc_errors.ErrInvalidSubscriptionID
+	}
+	if in.UserID == "" {
+		return dto.AddMemberResponse{Added: false}, uc_errors.ErrEmptyUserID
+	}
+	if (in.SharePercent == nil) == (in.ShareAmount == nil) {
+		return dto.AddMemberResponse{Added: false}, uc_errors.ErrInvalidShare
+	}
+	if in.SharePercent != nil && (*in.SharePercent <= 0 || *in.SharePercent > 100) {
+		return dto.AddMemberResponse{Added: false}, uc_errors.ErrInvalidShare
+	}
+	if in.ShareAmount != nil && *in.ShareAmount < 0 {
+		return dto.AddMemberResponse{Added: false}, uc_errors.ErrInvalidShare
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	uid, err := uuid.Parse(in.UserID)
+	if err != nil || uid == uuid.Nil {
+		return dto.AddMemberResponse{Added: false}, uc_errors.ErrInvalidUserID
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	sub, err := uc.Subscriptions.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.AddMemberResponse{Added: false},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.AddMemberResponse{Added: false},
+			uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	if sub.UserID == uid {
+		return dto.AddMemberResponse{Added: false}, uc_errors.ErrMemberIsOwner
+	}
+
+	// the share of a member being changed is replaced, not added to
+	percent, amount := 0.0, 0
+	if in.SharePercent != nil {
+		percent += *in.SharePercent
+	} else {
+		amount += *in.ShareAmount
+	}
+	for _, m := range sub.Members {
+		if m.UserID == uid {
+			continue
+		}
+		if m.SharePercent != nil {
+			percent += *m.SharePercent
+		} else if m.ShareAmount != nil {
+			amount += *m.ShareAmount
+		}
+	}
+
+	monthly := float64(sub.MonthlyPrice())
+	if percent > 100 || float64(amount)+monthly*percent/100 > monthly {
+		return dto.AddMemberResponse{Added: false}, uc_errors.ErrSharesOverTotal
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	err = uc.Subscriptions.AddMember(ctx, entity.Member{
+		SubscriptionID: sub.ID,
+		UserID:         uid,
+		SharePercent:   in.SharePercent,
+		ShareAmount:    in.ShareAmount,
+	})
+	if err != nil {
+		return dto.AddMemberResponse{Added: false},
+			uc_errors.Wrap(uc_errors.ErrAddMember, err)
+	}
+
+	return dto.AddMemberResponse{Added: true}, nil
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	memberOwnerID = uuid.MustParse("0b7e3c1a-5f2d-4e8a-9c6b-7d1f2a3e4b5c")
+	memberUserID  = uuid.MustParse("9d4a6e2b-1c3f-4a5e-8b7d-6c5e4f3a2b1d")
+)
+
+type AddMemberCase struct {
+	Name          string
+	Input         dto.AddMember
+	GetRepoOutput *entity.Subscription
+	RepoInput     *entity.Member
+	Output        dto.AddMemberResponse
+	WantErr       error
+	GetRepoErr    error
+	RepoErr       error
+}
+
+var AddMemberCases = []AddMemberCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.AddMember{ID: 0, UserID: memberUserID.String(), SharePercent: vPtr(50.0)},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "empty user id",
+		Input:   dto.AddMember{ID: 1, SharePercent: vPtr(50.0)},
+		WantErr: uc_errors.ErrEmptyUserID,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.AddMember{ID: 1, UserID: "not-a-uuid", SharePercent: vPtr(50.0)},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "no share",
+		Input:   dto.AddMember{ID: 1, UserID: memberUserID.String()},
+		WantErr: uc_errors.ErrInvalidShare,
+	},
+
+	{
+		Name: "both shares",
+		Input: dto.AddMember{
+			ID:           1,
+			UserID:       memberUserID.String(),
+			SharePercent: vPtr(50.0),
+			ShareAmount:  vPtr(100),
+		},
+		WantErr: uc_errors.ErrInvalidShare,
+	},
+
+	{
+		Name:    "percent over 100",
+		Input:   dto.AddMember{ID: 1, UserID: memberUserID.String(), SharePercent: vPtr(120.0)},
+		WantErr: uc_errors.ErrInvalidShare,
+	},
+
+	{
+		Name:    "negative amount",
+		Input:   dto.AddMember{ID: 1, UserID: memberUserID.String(), ShareAmount: vPtr(-1)},
+		WantErr: uc_errors.ErrInvalidShare,
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.AddMember{ID: 1, UserID: memberUserID.String(), SharePercent: vPtr(50.0)},
+		WantErr:    uc_errors.ErrSubscriptionNotFound,
+		GetRepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:       "get error",
+		Input:      dto.AddMember{ID: 1, UserID: memberUserID.String(), SharePercent: vPtr(50.0)},
+		WantErr:    uc_errors.ErrGetSubscription,
+		GetRepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:          "owner as member",
+		Input:         dto.AddMember{ID: 1, UserID: memberOwnerID.String(), SharePercent: vPtr(50.0)},
+		GetRepoOutput: &entity.Subscription{ID: 1, UserID: memberOwnerID},
+		WantErr:       uc_errors.ErrMemberIsOwner,
+	},
+
+	{
+		Name:  "shares over 100 percent",
+		Input: dto.AddMember{ID: 1, UserID: memberUserID.String(), SharePercent: vPtr(50.0)},
+		GetRepoOutput: &entity.Subscription{
+			ID:     1,
+			UserID: memberOwnerID,
+			Members: []entity.Member{
+				{SubscriptionID: 1, UserID: uuid.New(), SharePercent: vPtr(30.0)},
+				{SubscriptionID: 1, UserID: uuid.New(), SharePercent: vPtr(25.0)},
+			},
+		},
+		WantErr: uc_errors.ErrSharesOverTotal,
+	},
+
+	{
+		Name:  "fixed shares over price",
+		Input: dto.AddMember{ID: 1, UserID: memberUserID.String(), ShareAmount: vPtr(800)},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			UserID:        memberOwnerID,
+			Price:         1000,
+			Seats:         1,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			Members: []entity.Member{
+				{SubscriptionID: 1, UserID: uuid.New(), ShareAmount: vPtr(800)},
+			},
+		},
+		WantErr: uc_errors.ErrSharesOverTotal,
+	},
+
+	{
+		Name:  "fixed and percent shares over price",
+		Input: dto.AddMember{ID: 1, UserID: memberUserID.String(), SharePercent: vPtr(100.0)},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			UserID:        memberOwnerID,
+			Price:         1000,
+			Seats:         1,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			Members: []entity.Member{
+				{SubscriptionID: 1, UserID: uuid.New(), ShareAmount: vPtr(1)},
+			},
+		},
+		WantErr: uc_errors.ErrSharesOverTotal,
+	},
+
+	{
+		Name:  "repository error",
+		Input: dto.AddMember{ID: 1, UserID: memberUserID.String(), ShareAmount: vPtr(100)},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			UserID:        memberOwnerID,
+			Price:         1000,
+			Seats:         1,
+			BillingPeriod: entity.BillingPeriodMonthly,
+		},
+		WantErr: uc_errors.ErrAddMember,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "success change own share",
+		Input: dto.AddMember{ID: 1, UserID: memberUserID.String(), SharePercent: vPtr(70.0)},
+		GetRepoOutput: &entity.Subscription{
+			ID:     1,
+			UserID: memberOwnerID,
+			Members: []entity.Member{
+				{SubscriptionID: 1, UserID: memberUserID, SharePercent: vPtr(50.0)},
+				{SubscriptionID: 1, UserID: uuid.New(), SharePercent: vPtr(30.0)},
+			},
+		},
+		RepoInput: &entity.Member{
+			SubscriptionID: 1,
+			UserID:         memberUserID,
+			SharePercent:   vPtr(70.0),
+		},
+		Output: dto.AddMemberResponse{Added: true},
+	},
+
+	{
+		Name:  "success fixed amount",
+		Input: dto.AddMember{ID: 1, UserID: memberUserID.String(), ShareAmount: vPtr(150)},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			UserID:        memberOwnerID,
+			Price:         1000,
+			Seats:         1,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			Members: []entity.Member{
+				{SubscriptionID: 1, UserID: uuid.New(), SharePercent: vPtr(50.0)},
+				{SubscriptionID: 1, UserID: uuid.New(), ShareAmount: vPtr(350)},
+			},
+		},
+		RepoInput: &entity.Member{
+			SubscriptionID: 1,
+			UserID:         memberUserID,
+			ShareAmount:    vPtr(150),
+		},
+		Output: dto.AddMemberResponse{Added: true},
+	},
+}
+
+func TestAddMemberUC(t *testing.T) {
+	for _, tt := range AddMemberCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			uc := &AddMemberUC{Subscriptions: repo}
+
+			shouldCallGet :=
+				tt.GetRepoOutput != nil ||
+					tt.GetRepoErr != nil
+
+			shouldCallAdd :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrAddMember)
+
+			if shouldCallGet {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.GetRepoOutput, tt.GetRepoErr)
+			}
+			if shouldCallAdd {
+				var m any = mock.Anything
+				if tt.RepoInput != nil {
+					m = *tt.RepoInput
+				}
+				repo.On("AddMember", mock.Anything, m).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// AllocateSubscriptionUC replaces the cost centre allocations of a
+// subscription; an empty list leaves all of its spend unallocated.
+type AllocateSubscriptionUC struct {
+	Subscriptions port.SubscriptionRepository
+	CostCentres   port.CostCentreRepository
+}
+
+func (uc *AllocateSubscriptionUC) Execute(ctx context.Context, in dto.AllocateSubscription) (dto.AllocateSubscriptionResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.AllocateSubscriptionResponse{Allocated: false}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	var total float64
+	seen := make(map[int]bool, len(in.Allocations))
+
+	for _, a := range in.Allocations {
+		if a.CostCentreID <= 0 || seen[a.CostCentreID] || a.Percent <= 0 || a.Percent > 100 {
+			return dto.AllocateSubscriptionResponse{Allocated: false}, uc_errors.ErrInvalidAllocation
+		}
+		seen[a.CostCentreID] = true
+		total += a.Percent
+	}
+
+	if total > 100 {
+		return dto.AllocateSubscriptionResponse{Allocated: false}, uc_errors.ErrAllocationsOverTotal
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	sub, err := uc.Subscriptions.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.AllocateSubscriptionResponse{Allocated: false},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.AllocateSubscriptionResponse{Allocated: false},
+			uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	allocations := make([]entity.Allocation, 0, len(in.Allocations))
+	for _, a := range in.Allocations {
+		if _, err := uc.CostCentres.Get(ctx, a.CostCentreID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return dto.AllocateSubscriptionResponse{Allocated: false},
+					uc_errors.Wrap(uc_errors.ErrCostCentreNotFound, err)
+			}
+			return dto.AllocateSubscriptionResponse{Allocated: false},
+				uc_errors.Wrap(uc_errors.ErrGetCostCentre, err)
+		}
+
+		allocations = append(allocations, entity.Allocation{
+			SubscriptionID: sub.ID,
+			CostCentreID:   a.CostCentreID,
+			Percent:        a.Percent,
+		})
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.Subscriptions.SetAllocations(ctx, sub.ID, allocations); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.AllocateSubscriptionResponse{Allocated: false},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.AllocateSubscriptionResponse{Allocated: false},
+			uc_errors.Wrap(uc_errors.ErrAllocateSubscription, err)
+	}
+
+	return dto.AllocateSubscriptionResponse{Allocated: true}, nil
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type AllocateSubscriptionCase struct {
+	Name            string
+	Input           dto.AllocateSubscription
+	Current         *entity.Subscription
+	GetErr          error
+	CentreErrs      map[int]error
+	WantAllocations []entity.Allocation
+	Output          dto.AllocateSubscriptionResponse
+	WantErr         error
+	RepoErr         error
+}
+
+var AllocateSubscriptionCases = []AllocateSubscriptionCase{
+	{
+		Name:    "invalid subscription id",
+		Input:   dto.AllocateSubscription{ID: 0},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name: "invalid cost centre id",
+		Input: dto.AllocateSubscription{ID: 1, Allocations: []dto.Allocation{
+			{CostCentreID: 0, Percent: 50},
+		}},
+		WantErr: uc_errors.ErrInvalidAllocation,
+	},
+
+	{
+		Name: "zero percent",
+		Input: dto.AllocateSubscription{ID: 1, Allocations: []dto.Allocation{
+			{CostCentreID: 1, Percent: 0},
+		}},
+		WantErr: uc_errors.ErrInvalidAllocation,
+	},
+
+	{
+		Name: "cost centre listed twice",
+		Input: dto.AllocateSubscription{ID: 1, Allocations: []dto.Allocation{
+			{CostCentreID: 1, Percent: 20},
+			{CostCentreID: 1, Percent: 30},
+		}},
+		WantErr: uc_errors.ErrInvalidAllocation,
+	},
+
+	{
+		Name: "over 100 percent",
+		Input: dto.AllocateSubscription{ID: 1, Allocations: []dto.Allocation{
+			{CostCentreID: 1, Percent: 60},
+			{CostCentreID: 2, Percent: 50},
+		}},
+		WantErr: uc_errors.ErrAllocationsOverTotal,
+	},
+
+	{
+		Name:    "subscription not found",
+		Input:   dto.AllocateSubscription{ID: 1},
+		GetErr:  sql.ErrNoRows,
+		WantErr: uc_errors.ErrSubscriptionNotFound,
+	},
+
+	{
+		Name: "cost centre not found",
+		Input: dto.AllocateSubscription{ID: 1, Allocations: []dto.Allocation{
+			{CostCentreID: 7, Percent: 40},
+		}},
+		Current:    &entity.Subscription{ID: 1},
+		CentreErrs: map[int]error{7: sql.ErrNoRows},
+		WantErr:    uc_errors.ErrCostCentreNotFound,
+	},
+
+	{
+		Name: "repository error",
+		Input: dto.AllocateSubscription{ID: 1, Allocations: []dto.Allocation{
+			{CostCentreID: 1, Percent: 100},
+		}},
+		Current:         &entity.Subscription{ID: 1},
+		WantAllocations: []entity.Allocation{{SubscriptionID: 1, CostCentreID: 1, Percent: 100}},
+		WantErr:         uc_errors.ErrAllocateSubscription,
+		RepoErr:         errors.New("db error"),
+	},
+
+	{
+		Name: "success split",
+		Input: dto.AllocateSubscription{ID: 1, Allocations: []dto.Allocation{
+			{CostCentreID: 1, Percent: 60},
+			{CostCentreID: 2, Percent: 25.5},
+		}},
+		Current: &entity.Subscription{ID: 1},
+		WantAllocations: []entity.Allocation{
+			{SubscriptionID: 1, CostCentreID: 1, Percent: 60},
+			{SubscriptionID: 1, CostCentreID: 2, Percent: 25.5},
+		},
+		Output: dto.AllocateSubscriptionResponse{Allocated: true},
+	},
+
+	{
+		Name:            "success clear",
+		Input:           dto.AllocateSubscription{ID: 1},
+		Current:         &entity.Subscription{ID: 1},
+		WantAllocations: []entity.Allocation{},
+		Output:          dto.AllocateSubscriptionResponse{Allocated: true},
+	},
+}
+
+func TestAllocateSubscriptionUC(t *testing.T) {
+	for _, tt := range AllocateSubscriptionCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			subs := new(mocks.SubscriptionRepository)
+			centres := new(mocks.CostCentreRepository)
+			uc := &AllocateSubscriptionUC{Subscriptions: subs, CostCentres: centres}
+
+			if tt.Current != nil || tt.GetErr != nil {
+				subs.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.Current, tt.GetErr)
+			}
+
+			centres.On("Get", mock.Anything, mock.Anything).
+				Return(func(_ context.Context, id int) (*entity.CostCentre, error) {
+					if err, ok := tt.CentreErrs[id]; ok {
+						return nil, err
+					}
+					return &entity.CostCentre{ID: id}, nil
+				}).Maybe()
+
+			if tt.WantAllocations != nil {
+				subs.On("SetAllocations", mock.Anything, tt.Input.ID, tt.WantAllocations).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			subs.AssertExpectations(t)
+			centres.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// currentMonth is the first day of the month of today.
+func currentMonth() time.Time {
+	now := today()
+	return now.AddDate(0, 0, 1-now.Day())
+}
+
+// budgetSpend adds up what the budget's user is charged in the month starting
+// at month on the services and category the budget covers, in the budget's
+// currency.
+func budgetSpend(
+	ctx context.Context,
+	subs port.SubscriptionRepository,
+	rates port.ExchangeRateRepository,
+	b entity.Budget,
+	month time.Time,
+) (entity.Money, error) {
+	end := month.AddDate(0, 1, -1)
+
+	sums, err := subs.GetMonthlySums(ctx, filter.SumFilter{
+		UserID:      &b.UserID,
+		ServiceName: b.ServiceName,
+		Category:    b.Category,
+		StartDate:   &month,
+		EndDate:     &end,
+	})
+	if err != nil {
+		return entity.Money{}, uc_errors.Wrap(uc_errors.ErrCheckBudgets, err)
+	}
+
+	return convertMonthlySums(ctx, rates, sums, b.Currency)
+}
+
+// checkBudgets runs after a subscription was written: it notifies about and
+// returns a warning for every budget of the subscription's user covering its
+// service whose limit this month's spend is over. The write stands when the
+// check fails; the failure is logged and no warnings are returned.
+func checkBudgets(
+	ctx context.Context,
+	log *slog.Logger,
+	budgets port.BudgetRepository,
+	services port.ServiceRepository,
+	subs port.SubscriptionRepository,
+	rates port.ExchangeRateRepository,
+	notifier port.BudgetNotifier,
+	sub *entity.Subscription,
+) []dto.BudgetWarning {
+	warnings, err := exceededBudgets(ctx, budgets, services, subs, rates, notifier, sub)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to check budgets",
+			slog.Int("subscription_id", sub.ID),
+			slog.Any("cause", err),
+		)
+		return nil
+	}
+	return warnings
+}
+
+func exceededBudgets(
+	ctx context.Context,
+	budgets port.BudgetRepository,
+	services port.ServiceRepository,
+	subs port.SubscriptionRepository,
+	rates port.ExchangeRateRepository,
+	notifier port.BudgetNotifier,
+	sub *entity.Subscription,
+) ([]dto.BudgetWarning, error) {
+	list, err := budgets.GetList(ctx, filter.BudgetFilter{UserID: &sub.UserID})
+	if err != nil {
+		return nil, uc_errors.Wrap(uc_errors.ErrCheckBudgets, err)
+	}
+
+	service, err := budgetedService(ctx, services, sub, list)
+	if err != nil {
+		return nil, err
+	}
+
+	month := currentMonth()
+	var warnings []dto.BudgetWarning
+
+	for _, b := range list {
+		if !b.Covers(sub, service) {
+			continue
+		}
+
+		spent, err := budgetSpend(ctx, subs, rates, b, month)
+		if err != nil {
+			return nil, err
+		}
+		if spent.Amount <= b.MonthlyLimit {
+			continue
+		}
+
+		alert := entity.BudgetAlert{Budget: b, Month: month, Spent: spent}
+		notifier.BudgetExceeded(ctx, alert)
+		warnings = append(warnings, mappers.MapIntoBudgetWarningDTO(alert))
+	}
+
+	return warnings, nil
+}
+
+// budgetedService loads the catalog entry sub is filed under when one of the
+// budgets names a service by another name than the subscription's, which may
+// be an alias of it.
+func budgetedService(
+	ctx context.Context,
+	services port.ServiceRepository,
+	sub *entity.Subscription,
+	list []entity.Budget,
+) (*entity.Service, error) {
+	if sub.ServiceID == nil {
+		return nil, nil
+	}
+
+	for _, b := range list {
+		if b.ServiceName == nil || *b.ServiceName == sub.ServiceName {
+			continue
+		}
+
+		service, err := services.Get(ctx, *sub.ServiceID)
+		if err != nil {
+			return nil, uc_errors.Wrap(uc_errors.ErrCheckBudgets, err)
+		}
+		return service, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type CancelSubscriptionUC struct {
+	Subscriptions port.SubscriptionRepository
+	Charges       port.ChargeRepository
+	Log           *slog.Logger
+
+	TerminationFees entity.TerminationFeePolicy
+}
+
+func (uc *CancelSubscriptionUC) Execute(ctx context.Context, in dto.CancelSubscription) (dto.CancelSubscriptionResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.CancelSubscriptionResponse{Cancelled: false}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return dto.CancelSubscriptionResponse{Cancelled: false}, uc_errors.ErrEmptyCancellationReason
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	sub, err := uc.Subscriptions.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.CancelSubscriptionResponse{Cancelled: false},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.CancelSubscriptionResponse{Cancelled: false},
+			uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	if sub.CancelledAt != nil {
+		return dto.CancelSubscriptionResponse{Cancelled: false}, uc_errors.ErrSubscriptionCancelled
+	}
+
+	day := today()
+	if sub.EndDate != nil && sub.EndDate.Before(day) {
+		return dto.CancelSubscriptionResponse{Cancelled: false}, uc_errors.ErrSubscriptionEnded
+	}
+
+	// Cancelling now makes today the last day served, or the start date of a
+	// subscription that has not started yet; otherwise the period already
+	// paid for runs out. A fixed term ending sooner is kept.
+	end := day
+	if end.Before(sub.StartDate) {
+		end = sub.StartDate
+	}
+	if in.AtPeriodEnd {
+		end = sub.CurrentPeriodEnd(day)
+	}
+	if sub.EndDate != nil && sub.EndDate.Before(end) {
+		end = *sub.EndDate
+	}
+
+	now := time.Now().UTC()
+	sub.EndDate = &end
+	sub.CancelledAt = &now
+	sub.CancellationReason = &reason
+
+	// cancelling within the minimum term is only done once the caller has
+	// seen the fee and confirmed
+	fee := terminationFee(uc.TerminationFees, sub)
+	if fee != nil && !in.Confirm {
+		return dto.CancelSubscriptionResponse{
+			Cancelled:            false,
+			EndDate:              end.Format("02-01-2006"),
+			TerminationFee:       mappers.MapIntoTerminationFeeDTO(fee),
+			ConfirmationRequired: true,
+		}, nil
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.Subscriptions.Cancel(ctx, sub, fee); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.CancelSubscriptionResponse{Cancelled: false}, uc_errors.ErrSubscriptionCancelled
+		}
+		return dto.CancelSubscriptionResponse{Cancelled: false},
+			uc_errors.Wrap(uc_errors.ErrCancelSubscription, err)
+	}
+
+	syncCharges(ctx, uc.Log, uc.Charges, sub.ID)
+
+	return dto.CancelSubscriptionResponse{
+		Cancelled:      true,
+		EndDate:        end.Format("02-01-2006"),
+		TerminationFee: mappers.MapIntoTerminationFeeDTO(fee),
+	}, nil
+}
@@ -0,0 +1,345 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type CancelCase struct {
+	Name          string
+	Input         dto.CancelSubscription
+	GetRepoOutput *entity.Subscription
+	WantEndDate   time.Time
+	WantErr       error
+	GetRepoErr    error
+	RepoErr       error
+}
+
+// cancelDay is the day cancellations in the cases below take effect on.
+var cancelDay = today()
+
+// lastOfMonth is the last day of the month containing cancelDay.
+var lastOfMonth = time.Date(cancelDay.Year(), cancelDay.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+
+var CancelCases = []CancelCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.CancelSubscription{ID: 0, Reason: "too expensive"},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "empty reason",
+		Input:   dto.CancelSubscription{ID: 1, Reason: "  "},
+		WantErr: uc_errors.ErrEmptyCancellationReason,
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.CancelSubscription{ID: 1, Reason: "too expensive"},
+		WantErr:    uc_errors.ErrSubscriptionNotFound,
+		GetRepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:       "get error",
+		Input:      dto.CancelSubscription{ID: 1, Reason: "too expensive"},
+		WantErr:    uc_errors.ErrGetSubscription,
+		GetRepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "already cancelled",
+		Input: dto.CancelSubscription{ID: 1, Reason: "too expensive"},
+		GetRepoOutput: &entity.Subscription{
+			ID:          1,
+			StartDate:   parseTime("01-01-2020"),
+			CancelledAt: vPtr(time.Now()),
+		},
+		WantErr: uc_errors.ErrSubscriptionCancelled,
+	},
+
+	{
+		Name:  "already ended",
+		Input: dto.CancelSubscription{ID: 1, Reason: "too expensive"},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2020"),
+			EndDate:   vPtr(parseTime("01-01-2021")),
+		},
+		WantErr: uc_errors.ErrSubscriptionEnded,
+	},
+
+	{
+		Name:  "repository error",
+		Input: dto.CancelSubscription{ID: 1, Reason: "too expensive"},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			StartDate:     parseTime("01-01-2020"),
+		},
+		WantEndDate: cancelDay,
+		WantErr:     uc_errors.ErrCancelSubscription,
+		RepoErr:     errors.New("db error"),
+	},
+
+	{
+		Name:  "success cancel now",
+		Input: dto.CancelSubscription{ID: 1, Reason: "too expensive"},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			StartDate:     parseTime("01-01-2020"),
+		},
+		WantEndDate: cancelDay,
+	},
+
+	{
+		Name:  "success cancel now before start",
+		Input: dto.CancelSubscription{ID: 1, Reason: "changed my mind"},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			StartDate:     cancelDay.AddDate(0, 1, 0),
+		},
+		WantEndDate: cancelDay.AddDate(0, 1, 0),
+	},
+
+	{
+		Name:  "success cancel at period end",
+		Input: dto.CancelSubscription{ID: 1, Reason: "moving abroad", AtPeriodEnd: true},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			BillingPeriod: entity.BillingPeriodMonthly,
+			StartDate:     parseTime("01-01-2020"),
+		},
+		WantEndDate: lastOfMonth,
+	},
+
+	{
+		Name:  "success cancel keeps sooner end date",
+		Input: dto.CancelSubscription{ID: 1, Reason: "moving abroad", AtPeriodEnd: true},
+		GetRepoOutput: &entity.Subscription{
+			ID:            1,
+			BillingPeriod: entity.BillingPeriodYearly,
+			StartDate:     parseTime("01-01-2020"),
+			EndDate:       vPtr(cancelDay),
+		},
+		WantEndDate: cancelDay,
+	},
+}
+
+func TestCancelSubscriptionUC(t *testing.T) {
+	for _, tt := range CancelCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			uc := &CancelSubscriptionUC{Subscriptions: repo, Charges: charges}
+
+			shouldCallGet :=
+				tt.GetRepoOutput != nil ||
+					tt.GetRepoErr != nil
+
+			shouldCallCancel :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrCancelSubscription)
+
+			if shouldCallGet {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.GetRepoOutput, tt.GetRepoErr)
+			}
+			if shouldCallCancel {
+				repo.On("Cancel", mock.Anything, mock.MatchedBy(func(s *entity.Subscription) bool {
+					return s.EndDate != nil && s.EndDate.Equal(tt.WantEndDate) &&
+						s.CancelledAt != nil &&
+						s.CancellationReason != nil && *s.CancellationReason == tt.Input.Reason
+				}), (*entity.OneOffCharge)(nil)).Return(tt.RepoErr)
+			}
+
+			if tt.WantErr == nil {
+				charges.On("Sync", mock.Anything, tt.Input.ID, ledgerHorizon()).
+					Return(nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.CancelSubscriptionResponse{
+					Cancelled: true,
+					EndDate:   tt.WantEndDate.Format("02-01-2006"),
+				}, resp)
+			}
+
+			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+		})
+	}
+}
+
+type CancelTerminationFeeCase struct {
+	Name      string
+	Input     dto.CancelSubscription
+	StartDate time.Time
+	Policy    entity.TerminationFeePolicy
+	RepoErr   error
+	WantFee   *dto.Money
+	WantResp  dto.CancelSubscriptionResponse
+	WantErr   error
+}
+
+func TestCancelSubscriptionUC_TerminationFee(t *testing.T) {
+	remaining := entity.TerminationFeePolicy{Rule: entity.TerminationFeeRemainingMonths}
+	// a year-long term started on cancelDay has all of its 12 months left
+	fee := &dto.Money{Amount: 12000, Currency: "RUB"}
+	// a term starting a year before next month ends on lastOfMonth
+	termEndsThisMonth := time.Date(cancelDay.Year()-1, cancelDay.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := cancelDay.Format("02-01-2006")
+
+	cases := []CancelTerminationFeeCase{
+		{
+			Name:      "early cancel needs confirmation",
+			Input:     dto.CancelSubscription{ID: 1, Reason: "too expensive"},
+			StartDate: cancelDay,
+			Policy:    remaining,
+			WantFee:   fee,
+			WantResp: dto.CancelSubscriptionResponse{
+				Cancelled:            false,
+				EndDate:              endDate,
+				TerminationFee:       fee,
+				ConfirmationRequired: true,
+			},
+		},
+
+		{
+			Name:      "cancel before start charges the term once",
+			Input:     dto.CancelSubscription{ID: 1, Reason: "too expensive"},
+			StartDate: cancelDay.AddDate(0, 2, 0),
+			Policy:    remaining,
+			WantFee:   fee,
+			WantResp: dto.CancelSubscriptionResponse{
+				Cancelled:            false,
+				EndDate:              cancelDay.AddDate(0, 2, 0).Format("02-01-2006"),
+				TerminationFee:       fee,
+				ConfirmationRequired: true,
+			},
+		},
+
+		{
+			Name:      "confirmed early cancel charges remaining months",
+			Input:     dto.CancelSubscription{ID: 1, Reason: "too expensive", Confirm: true},
+			StartDate: cancelDay,
+			Policy:    remaining,
+			WantFee:   fee,
+			WantResp: dto.CancelSubscriptionResponse{
+				Cancelled:      true,
+				EndDate:        endDate,
+				TerminationFee: fee,
+			},
+		},
+
+		{
+			Name:      "confirmed early cancel charges flat fee",
+			Input:     dto.CancelSubscription{ID: 1, Reason: "too expensive", Confirm: true},
+			StartDate: cancelDay,
+			Policy:    entity.TerminationFeePolicy{Rule: entity.TerminationFeeFlat, FlatAmount: 5000},
+			WantFee:   &dto.Money{Amount: 5000, Currency: "RUB"},
+			WantResp: dto.CancelSubscriptionResponse{
+				Cancelled:      true,
+				EndDate:        endDate,
+				TerminationFee: &dto.Money{Amount: 5000, Currency: "RUB"},
+			},
+		},
+
+		{
+			Name:      "cancel at term end has no fee",
+			Input:     dto.CancelSubscription{ID: 1, Reason: "moving abroad", AtPeriodEnd: true},
+			StartDate: termEndsThisMonth,
+			Policy:    remaining,
+			WantResp: dto.CancelSubscriptionResponse{
+				Cancelled: true,
+				EndDate:   lastOfMonth.Format("02-01-2006"),
+			},
+		},
+
+		{
+			Name:      "repository error records no fee",
+			Input:     dto.CancelSubscription{ID: 1, Reason: "too expensive", Confirm: true},
+			StartDate: cancelDay,
+			Policy:    remaining,
+			WantFee:   fee,
+			RepoErr:   errors.New("db error"),
+			WantErr:   uc_errors.ErrCancelSubscription,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			uc := &CancelSubscriptionUC{
+				Subscriptions:   repo,
+				Charges:         charges,
+				TerminationFees: tt.Policy,
+			}
+
+			repo.On("Get", mock.Anything, 1).
+				Return(&entity.Subscription{
+					ID:                1,
+					ServiceName:       "Jira",
+					Price:             1000,
+					Seats:             1,
+					Currency:          entity.DefaultCurrency,
+					BillingPeriod:     entity.BillingPeriodMonthly,
+					StartDate:         tt.StartDate,
+					MinimumTermMonths: vPtr(12),
+				}, nil)
+
+			preview := tt.WantFee != nil && !tt.Input.Confirm
+			if !preview {
+				repo.On("Cancel", mock.Anything, mock.Anything,
+					mock.MatchedBy(func(f *entity.OneOffCharge) bool {
+						if tt.WantFee == nil {
+							return f == nil
+						}
+						return f != nil &&
+							f.Amount == tt.WantFee.Amount &&
+							f.Date.Equal(cancelDay) &&
+							f.TerminationFee
+					})).Return(tt.RepoErr)
+			}
+			if !preview && tt.WantErr == nil {
+				charges.On("Sync", mock.Anything, 1, ledgerHorizon()).
+					Return(nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.WantResp, resp)
+			}
+
+			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type ChangeSeatsUC struct {
+	Subscriptions port.SubscriptionRepository
+	Services      port.ServiceRepository
+	Charges       port.ChargeRepository
+	Budgets       port.BudgetRepository
+	Rates         port.ExchangeRateRepository
+	Notifier      port.BudgetNotifier
+	Log           *slog.Logger
+}
+
+// Execute opens a new seat period, so that months before it keep being
+// charged for the seats in effect then.
+func (uc *ChangeSeatsUC) Execute(ctx context.Context, in dto.ChangeSeats) (dto.ChangeSeatsResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.ChangeSeatsResponse{Changed: false}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	errs := &entity.ValidationError{}
+
+	if in.Seats < 1 {
+		errs.Add("seats", entity.CodeInvalid, entity.ErrNoSeats)
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	effective, err := parseDayOrToday(in.EffectiveFrom)
+	if err != nil {
+		errs.Add("effective_from", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+	}
+
+	if err := errs.Err(); err != nil {
+		return dto.ChangeSeatsResponse{Changed: false}, err
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	sub, err := uc.Subscriptions.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.ChangeSeatsResponse{Changed: false},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.ChangeSeatsResponse{Changed: false},
+			uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	err = uc.Subscriptions.ChangeSeats(ctx, entity.SeatPeriod{
+		SubscriptionID: sub.ID,
+		Seats:          in.Seats,
+		EffectiveFrom:  effective,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.ChangeSeatsResponse{Changed: false},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.ChangeSeatsResponse{Changed: false},
+			uc_errors.Wrap(uc_errors.ErrChangeSeats, err)
+	}
+
+	syncCharges(ctx, uc.Log, uc.Charges, sub.ID)
+
+	warnings := checkBudgets(ctx, uc.Log, uc.Budgets, uc.Services, uc.Subscriptions, uc.Rates, uc.Notifier, sub)
+
+	return dto.ChangeSeatsResponse{Changed: true, Warnings: warnings}, nil
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ChangeSeatsCase struct {
+	Name          string
+	Input         dto.ChangeSeats
+	GetRepoOutput *entity.Subscription
+	RepoInput     *entity.SeatPeriod
+	Output        dto.ChangeSeatsResponse
+	WantErr       error
+	GetRepoErr    error
+	RepoErr       error
+	SyncErr       error
+}
+
+var ChangeSeatsCases = []ChangeSeatsCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.ChangeSeats{ID: 0, Seats: 5},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "no seats",
+		Input:   dto.ChangeSeats{ID: 1, Seats: 0},
+		WantErr: entity.ErrNoSeats,
+	},
+
+	{
+		Name:    "invalid date",
+		Input:   dto.ChangeSeats{ID: 1, Seats: 5, EffectiveFrom: vPtr("2025-03-01")},
+		WantErr: uc_errors.ErrInvalidDate,
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.ChangeSeats{ID: 1, Seats: 5},
+		WantErr:    uc_errors.ErrSubscriptionNotFound,
+		GetRepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:       "get error",
+		Input:      dto.ChangeSeats{ID: 1, Seats: 5},
+		WantErr:    uc_errors.ErrGetSubscription,
+		GetRepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:          "repository error",
+		Input:         dto.ChangeSeats{ID: 1, Seats: 5},
+		GetRepoOutput: &entity.Subscription{ID: 1, Price: 100, Seats: 3},
+		WantErr:       uc_errors.ErrChangeSeats,
+		RepoErr:       errors.New("db error"),
+	},
+
+	{
+		Name:          "charges ledger error keeps the write",
+		Input:         dto.ChangeSeats{ID: 1, Seats: 5},
+		GetRepoOutput: &entity.Subscription{ID: 1, Price: 100, Seats: 3},
+		Output:        dto.ChangeSeatsResponse{Changed: true},
+		SyncErr:       errors.New("db error"),
+	},
+
+	{
+		Name:          "success from date",
+		Input:         dto.ChangeSeats{ID: 1, Seats: 5, EffectiveFrom: vPtr("01-03-2025")},
+		GetRepoOutput: &entity.Subscription{ID: 1, Price: 100, Seats: 3},
+		RepoInput: &entity.SeatPeriod{
+			SubscriptionID: 1,
+			Seats:          5,
+			EffectiveFrom:  parseTime("01-03-2025"),
+		},
+		Output: dto.ChangeSeatsResponse{Changed: true},
+	},
+}
+
+func TestChangeSeatsUC(t *testing.T) {
+	for _, tt := range ChangeSeatsCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			budgets := new(mocks.BudgetRepository)
+			uc := &ChangeSeatsUC{
+				Subscriptions: repo,
+				Charges:       charges,
+				Budgets:       budgets,
+				Log:           testLog,
+			}
+
+			shouldCallGet :=
+				tt.GetRepoOutput != nil ||
+					tt.GetRepoErr != nil
+
+			shouldCallChange :=
+				tt.GetRepoOutput != nil
+
+			shouldSync :=
+				shouldCallChange &&
+					tt.RepoErr == nil
+
+			if shouldCallGet {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.GetRepoOutput, tt.GetRepoErr)
+			}
+			if shouldCallChange {
+				var p any = mock.Anything
+				if tt.RepoInput != nil {
+					p = *tt.RepoInput
+				}
+				repo.On("ChangeSeats", mock.Anything, p).
+					Return(tt.RepoErr)
+			}
+			if shouldSync {
+				charges.On("Sync", mock.Anything, tt.Input.ID, ledgerHorizon()).
+					Return(tt.SyncErr)
+			}
+			if tt.WantErr == nil {
+				budgets.On("GetList", mock.Anything, mock.Anything).
+					Return([]entity.Budget(nil), nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+			budgets.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// ledgerHorizon is how far ahead the charges ledger is filled in.
+func ledgerHorizon() time.Time {
+	return today().AddDate(1, 0, 0)
+}
+
+// syncCharges brings the ledger in line with a subscription that was just
+// written. The write stands when this fails: the failure is logged and the
+// ledger catches up on the next change of the subscription.
+func syncCharges(ctx context.Context, log *slog.Logger, charges port.ChargeRepository, subscriptionID int) {
+	if err := charges.Sync(ctx, subscriptionID, ledgerHorizon()); err != nil {
+		log.ErrorContext(ctx, "failed to update charges ledger",
+			slog.Int("subscription_id", subscriptionID),
+			slog.Any("cause", err),
+		)
+	}
+}
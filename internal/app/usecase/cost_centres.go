@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// cleanCostCentre trims a cost centre's name and code, dropping an empty
+// code.
+func cleanCostCentre(name string, code *string) (string, *string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", nil, uc_errors.ErrEmptyCostCentreName
+	}
+
+	if code != nil {
+		c := strings.TrimSpace(*code)
+		if c == "" {
+			return name, nil, nil
+		}
+		code = &c
+	}
+
+	return name, code, nil
+}
+
+// checkCostCentreName makes sure that no cost centre other than the one with
+// the given id goes by the name.
+func checkCostCentreName(ctx context.Context, centres port.CostCentreRepository, id int, name string) error {
+	c, err := centres.FindByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return uc_errors.Wrap(uc_errors.ErrGetCostCentre, err)
+	}
+	if c.ID != id {
+		return uc_errors.ErrCostCentreNameTaken
+	}
+
+	return nil
+}
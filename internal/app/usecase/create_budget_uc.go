@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type CreateBudgetUC struct {
+	Budgets port.BudgetRepository
+}
+
+func (uc *CreateBudgetUC) Execute(ctx context.Context, in dto.CreateBudget) (dto.CreateBudgetResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.UserID == "" {
+		return dto.CreateBudgetResponse{}, uc_errors.ErrEmptyUserID
+	}
+	if in.MonthlyLimit < 0 {
+		return dto.CreateBudgetResponse{}, uc_errors.ErrInvalidMonthlyLimit
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	uid, err := uuid.Parse(in.UserID)
+	if err != nil || uid == uuid.Nil {
+		return dto.CreateBudgetResponse{}, uc_errors.ErrInvalidUserID
+	}
+
+	// an empty service name means the budget covers every service
+	var serviceNamePtr *string
+	if in.ServiceName != nil && *in.ServiceName != "" {
+		s := *in.ServiceName
+		serviceNamePtr = &s
+	}
+
+	// and an empty category every category
+	categoryPtr := cleanCategory(in.Category)
+
+	currency := entity.DefaultCurrency
+	if in.Currency != nil {
+		c, ok := entity.ParseCurrency(*in.Currency)
+		if !ok {
+			return dto.CreateBudgetResponse{}, uc_errors.ErrInvalidCurrency
+		}
+		currency = c
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	id, err := uc.Budgets.Create(ctx, &entity.Budget{
+		UserID:       uid,
+		ServiceName:  serviceNamePtr,
+		Category:     categoryPtr,
+		MonthlyLimit: in.MonthlyLimit,
+		Currency:     currency,
+	})
+	if err != nil {
+		return dto.CreateBudgetResponse{}, uc_errors.Wrap(uc_errors.ErrCreateBudget, err)
+	}
+
+	return dto.CreateBudgetResponse{ID: id}, nil
+}
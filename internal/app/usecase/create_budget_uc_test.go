@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var budgetUserID = uuid.MustParse("7f8a9c10-1111-4d2e-9a3b-0c1d2e3f4a5b")
+
+type CreateBudgetCase struct {
+	Name       string
+	Input      dto.CreateBudget
+	WantBudget *entity.Budget
+	RepoOutput int
+	Output     dto.CreateBudgetResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var CreateBudgetCases = []CreateBudgetCase{
+	{
+		Name:    "empty user id",
+		Input:   dto.CreateBudget{MonthlyLimit: 1000},
+		WantErr: uc_errors.ErrEmptyUserID,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.CreateBudget{UserID: "not-a-uuid", MonthlyLimit: 1000},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "negative limit",
+		Input:   dto.CreateBudget{UserID: budgetUserID.String(), MonthlyLimit: -1},
+		WantErr: uc_errors.ErrInvalidMonthlyLimit,
+	},
+
+	{
+		Name:    "invalid currency",
+		Input:   dto.CreateBudget{UserID: budgetUserID.String(), MonthlyLimit: 1000, Currency: vPtr("XXX")},
+		WantErr: uc_errors.ErrInvalidCurrency,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.CreateBudget{UserID: budgetUserID.String(), MonthlyLimit: 1000},
+		WantErr: uc_errors.ErrCreateBudget,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "success every service",
+		Input: dto.CreateBudget{UserID: budgetUserID.String(), ServiceName: vPtr(""), MonthlyLimit: 1000},
+		WantBudget: &entity.Budget{
+			UserID:       budgetUserID,
+			MonthlyLimit: 1000,
+			Currency:     entity.DefaultCurrency,
+		},
+		RepoOutput: 3,
+		Output:     dto.CreateBudgetResponse{ID: 3},
+	},
+
+	{
+		Name: "success one service",
+		Input: dto.CreateBudget{
+			UserID:       budgetUserID.String(),
+			ServiceName:  vPtr("Netflix"),
+			MonthlyLimit: 10,
+			Currency:     vPtr("usd"),
+		},
+		WantBudget: &entity.Budget{
+			UserID:       budgetUserID,
+			ServiceName:  vPtr("Netflix"),
+			MonthlyLimit: 10,
+			Currency:     "USD",
+		},
+		RepoOutput: 4,
+		Output:     dto.CreateBudgetResponse{ID: 4},
+	},
+}
+
+func TestCreateBudgetUC(t *testing.T) {
+	for _, tt := range CreateBudgetCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.BudgetRepository)
+			uc := &CreateBudgetUC{Budgets: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrCreateBudget)
+
+			if shouldCallRepo {
+				var want any = mock.AnythingOfType("*entity.Budget")
+				if tt.WantBudget != nil {
+					want = tt.WantBudget
+				}
+				repo.On("Create", mock.Anything, want).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
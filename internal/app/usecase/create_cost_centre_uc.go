@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type CreateCostCentreUC struct {
+	CostCentres port.CostCentreRepository
+}
+
+func (uc *CreateCostCentreUC) Execute(ctx context.Context, in dto.CreateCostCentre) (dto.CreateCostCentreResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	name, code, err := cleanCostCentre(in.Name, in.Code)
+	if err != nil {
+		return dto.CreateCostCentreResponse{}, err
+	}
+
+	if err := checkCostCentreName(ctx, uc.CostCentres, 0, name); err != nil {
+		return dto.CreateCostCentreResponse{}, err
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	id, err := uc.CostCentres.Create(ctx, &entity.CostCentre{
+		Name: name,
+		Code: code,
+	})
+	if err != nil {
+		return dto.CreateCostCentreResponse{}, uc_errors.Wrap(uc_errors.ErrCreateCostCentre, err)
+	}
+
+	return dto.CreateCostCentreResponse{ID: id}, nil
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type CreateCostCentreCase struct {
+	Name       string
+	Input      dto.CreateCostCentre
+	Taken      *entity.CostCentre
+	WantCentre *entity.CostCentre
+	RepoOutput int
+	Output     dto.CreateCostCentreResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var CreateCostCentreCases = []CreateCostCentreCase{
+	{
+		Name:    "empty name",
+		Input:   dto.CreateCostCentre{Name: "  "},
+		WantErr: uc_errors.ErrEmptyCostCentreName,
+	},
+
+	{
+		Name:    "name taken",
+		Input:   dto.CreateCostCentre{Name: "marketing"},
+		Taken:   &entity.CostCentre{ID: 2, Name: "Marketing"},
+		WantErr: uc_errors.ErrCostCentreNameTaken,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.CreateCostCentre{Name: "Marketing"},
+		WantCentre: &entity.CostCentre{Name: "Marketing"},
+		WantErr:    uc_errors.ErrCreateCostCentre,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success drops empty code",
+		Input:      dto.CreateCostCentre{Name: " Marketing ", Code: vPtr(" ")},
+		WantCentre: &entity.CostCentre{Name: "Marketing"},
+		RepoOutput: 3,
+		Output:     dto.CreateCostCentreResponse{ID: 3},
+	},
+
+	{
+		Name:       "success with code",
+		Input:      dto.CreateCostCentre{Name: "Engineering", Code: vPtr(" CC-100 ")},
+		WantCentre: &entity.CostCentre{Name: "Engineering", Code: vPtr("CC-100")},
+		RepoOutput: 4,
+		Output:     dto.CreateCostCentreResponse{ID: 4},
+	},
+}
+
+func TestCreateCostCentreUC(t *testing.T) {
+	for _, tt := range CreateCostCentreCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.CostCentreRepository)
+			uc := &CreateCostCentreUC{CostCentres: repo}
+
+			repo.On("FindByName", mock.Anything, mock.Anything).
+				Return(func(context.Context, string) (*entity.CostCentre, error) {
+					if tt.Taken != nil {
+						return tt.Taken, nil
+					}
+					return nil, sql.ErrNoRows
+				}).Maybe()
+
+			if tt.WantCentre != nil {
+				repo.On("Create", mock.Anything, tt.WantCentre).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type CreateOneOffChargeUC struct {
+	Charges       port.OneOffChargeRepository
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *CreateOneOffChargeUC) Execute(ctx context.Context, in dto.CreateOneOffCharge) (dto.CreateOneOffChargeResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.SubscriptionID == nil && (in.UserID == nil || *in.UserID == "") {
+		return dto.CreateOneOffChargeResponse{}, uc_errors.ErrNoChargeOwner
+	}
+	if in.SubscriptionID != nil && *in.SubscriptionID <= 0 {
+		return dto.CreateOneOffChargeResponse{}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	c := &entity.OneOffCharge{
+		SubscriptionID: in.SubscriptionID,
+		Description:    strings.TrimSpace(in.Description),
+		Money:          entity.Money{Amount: in.Amount, Currency: entity.DefaultCurrency},
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	// a charge of a subscription falls to its owner, in its currency, unless
+	// told otherwise
+	if in.SubscriptionID != nil {
+		sub, err := chargedSubscription(ctx, uc.Subscriptions, *in.SubscriptionID)
+		if err != nil {
+			return dto.CreateOneOffChargeResponse{}, err
+		}
+		c.UserID = sub.UserID
+		c.Currency = sub.Currency
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	// every problem with the input is collected, not just the first one
+	errs := &entity.ValidationError{}
+
+	if in.Date == "" {
+		errs.Add("date", entity.CodeRequired, uc_errors.ErrEmptyDate)
+	} else {
+		t, err := time.Parse("02-01-2006", in.Date)
+		if err != nil {
+			errs.Add("date", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+		}
+		c.Date = t
+	}
+
+	if in.UserID != nil && *in.UserID != "" {
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			errs.Add("user_id", entity.CodeInvalid, uc_errors.ErrInvalidUserID)
+		}
+		c.UserID = uid
+	}
+
+	if in.Currency != nil {
+		// an unknown code is reported by the charge itself
+		c.Currency, _ = entity.ParseCurrency(*in.Currency)
+	}
+
+	errs.Merge(c.Validate())
+
+	if err := errs.Err(); err != nil {
+		return dto.CreateOneOffChargeResponse{}, err
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	id, err := uc.Charges.Create(ctx, c)
+	if err != nil {
+		return dto.CreateOneOffChargeResponse{}, uc_errors.Wrap(uc_errors.ErrCreateOneOffCharge, err)
+	}
+
+	return dto.CreateOneOffChargeResponse{ID: id}, nil
+}
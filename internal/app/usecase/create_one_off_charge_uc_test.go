@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type CreateOneOffChargeCase struct {
+	Name       string
+	Input      dto.CreateOneOffCharge
+	Sub        *entity.Subscription
+	GetSubErr  error
+	WantCharge *entity.OneOffCharge
+	RepoOutput int
+	Output     dto.CreateOneOffChargeResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var (
+	oneOffUser  = uuid.MustParse("3f1c2a4e-8d7b-4c5a-9e6f-1a2b3c4d5e6f")
+	oneOffOwner = uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d")
+)
+
+var CreateOneOffChargeCases = []CreateOneOffChargeCase{
+	{
+		Name:    "neither subscription nor user",
+		Input:   dto.CreateOneOffCharge{Date: "15-03-2025", Amount: 500, Description: "setup fee"},
+		WantErr: uc_errors.ErrNoChargeOwner,
+	},
+
+	{
+		Name:    "invalid subscription id",
+		Input:   dto.CreateOneOffCharge{SubscriptionID: vPtr(-1), Date: "15-03-2025", Description: "setup fee"},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:      "subscription not found",
+		Input:     dto.CreateOneOffCharge{SubscriptionID: vPtr(7), Date: "15-03-2025", Description: "setup fee"},
+		GetSubErr: sql.ErrNoRows,
+		WantErr:   uc_errors.ErrSubscriptionNotFound,
+	},
+
+	{
+		Name:    "empty date",
+		Input:   dto.CreateOneOffCharge{UserID: vPtr(oneOffUser.String()), Description: "setup fee"},
+		WantErr: uc_errors.ErrEmptyDate,
+	},
+
+	{
+		Name: "repository error",
+		Input: dto.CreateOneOffCharge{
+			UserID:      vPtr(oneOffUser.String()),
+			Date:        "15-03-2025",
+			Amount:      500,
+			Description: "Conference ticket",
+		},
+		WantCharge: &entity.OneOffCharge{
+			UserID:      oneOffUser,
+			Date:        parseTime("15-03-2025"),
+			Description: "Conference ticket",
+			Money:       entity.Money{Amount: 500, Currency: entity.DefaultCurrency},
+		},
+		WantErr: uc_errors.ErrCreateOneOffCharge,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name: "success for a user",
+		Input: dto.CreateOneOffCharge{
+			UserID:      vPtr(oneOffUser.String()),
+			Date:        "15-03-2025",
+			Amount:      500,
+			Currency:    vPtr("usd"),
+			Description: " Conference ticket ",
+		},
+		WantCharge: &entity.OneOffCharge{
+			UserID:      oneOffUser,
+			Date:        parseTime("15-03-2025"),
+			Description: "Conference ticket",
+			Money:       entity.Money{Amount: 500, Currency: "USD"},
+		},
+		RepoOutput: 3,
+		Output:     dto.CreateOneOffChargeResponse{ID: 3},
+	},
+
+	{
+		Name: "success for a subscription takes its owner and currency",
+		Input: dto.CreateOneOffCharge{
+			SubscriptionID: vPtr(7),
+			Date:           "01-02-2025",
+			Amount:         2000,
+			Description:    "Setup fee",
+		},
+		Sub: &entity.Subscription{ID: 7, UserID: oneOffOwner, Currency: "EUR"},
+		WantCharge: &entity.OneOffCharge{
+			SubscriptionID: vPtr(7),
+			UserID:         oneOffOwner,
+			Date:           parseTime("01-02-2025"),
+			Description:    "Setup fee",
+			Money:          entity.Money{Amount: 2000, Currency: "EUR"},
+		},
+		RepoOutput: 4,
+		Output:     dto.CreateOneOffChargeResponse{ID: 4},
+	},
+
+	{
+		Name: "success for a subscription charged to another user",
+		Input: dto.CreateOneOffCharge{
+			SubscriptionID: vPtr(7),
+			UserID:         vPtr(oneOffUser.String()),
+			Date:           "01-02-2025",
+			Amount:         300,
+			Description:    "Overage",
+		},
+		Sub: &entity.Subscription{ID: 7, UserID: oneOffOwner, Currency: "EUR"},
+		WantCharge: &entity.OneOffCharge{
+			SubscriptionID: vPtr(7),
+			UserID:         oneOffUser,
+			Date:           parseTime("01-02-2025"),
+			Description:    "Overage",
+			Money:          entity.Money{Amount: 300, Currency: "EUR"},
+		},
+		RepoOutput: 5,
+		Output:     dto.CreateOneOffChargeResponse{ID: 5},
+	},
+}
+
+func TestCreateOneOffChargeUC(t *testing.T) {
+	for _, tt := range CreateOneOffChargeCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.OneOffChargeRepository)
+			subs := new(mocks.SubscriptionRepository)
+			uc := &CreateOneOffChargeUC{Charges: repo, Subscriptions: subs}
+
+			if tt.Sub != nil || tt.GetSubErr != nil {
+				subs.On("Get", mock.Anything, *tt.Input.SubscriptionID).
+					Return(tt.Sub, tt.GetSubErr)
+			}
+			if tt.WantCharge != nil {
+				repo.On("Create", mock.Anything, tt.WantCharge).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			subs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateOneOffChargeUC_AllFieldErrors(t *testing.T) {
+	uc := &CreateOneOffChargeUC{Charges: new(mocks.OneOffChargeRepository)}
+
+	_, err := uc.Execute(context.Background(), dto.CreateOneOffCharge{
+		UserID:      vPtr(oneOffUser.String()),
+		Date:        "2025-03-15",
+		Amount:      -1,
+		Currency:    vPtr("XYZ"),
+		Description: " ",
+	})
+
+	var verr *entity.ValidationError
+	assert.True(t, errors.As(err, &verr), "expected a validation error, got '%v'", err)
+	assert.Equal(t, []entity.FieldError{
+		{Field: "date", Code: entity.CodeInvalid, Err: uc_errors.ErrInvalidDate},
+		{Field: "amount", Code: entity.CodeNegative, Err: entity.ErrNegativeAmount},
+		{Field: "currency", Code: entity.CodeInvalid, Err: entity.ErrUnknownCurrency},
+		{Field: "description", Code: entity.CodeRequired, Err: entity.ErrNoDescription},
+	}, verr.Fields)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type CreateServiceUC struct {
+	Services port.ServiceRepository
+}
+
+func (uc *CreateServiceUC) Execute(ctx context.Context, in dto.CreateService) (dto.CreateServiceResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	name, aliases, err := cleanServiceNames(in.Name, in.Aliases)
+	if err != nil {
+		return dto.CreateServiceResponse{}, err
+	}
+
+	if err := checkServiceNames(ctx, uc.Services, 0, append([]string{name}, aliases...)...); err != nil {
+		return dto.CreateServiceResponse{}, err
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	id, err := uc.Services.Create(ctx, &entity.Service{
+		Name:    name,
+		Aliases: aliases,
+	})
+	if err != nil {
+		return dto.CreateServiceResponse{}, uc_errors.Wrap(uc_errors.ErrCreateService, err)
+	}
+
+	return dto.CreateServiceResponse{ID: id}, nil
+}
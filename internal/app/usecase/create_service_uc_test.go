@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type CreateServiceCase struct {
+	Name        string
+	Input       dto.CreateService
+	Taken       map[string]*entity.Service
+	WantService *entity.Service
+	RepoOutput  int
+	Output      dto.CreateServiceResponse
+	WantErr     error
+	RepoErr     error
+}
+
+var CreateServiceCases = []CreateServiceCase{
+	{
+		Name:    "empty name",
+		Input:   dto.CreateService{Name: "  "},
+		WantErr: uc_errors.ErrEmptyServiceName,
+	},
+
+	{
+		Name:    "empty alias",
+		Input:   dto.CreateService{Name: "Netflix", Aliases: []string{""}},
+		WantErr: uc_errors.ErrEmptyServiceAlias,
+	},
+
+	{
+		Name:    "alias taken",
+		Input:   dto.CreateService{Name: "YouTube", Aliases: []string{"YT"}},
+		Taken:   map[string]*entity.Service{"YT": {ID: 2, Name: "YouTube Premium"}},
+		WantErr: uc_errors.ErrServiceNameTaken,
+	},
+
+	{
+		Name:        "repository error",
+		Input:       dto.CreateService{Name: "Netflix"},
+		WantService: &entity.Service{Name: "Netflix", Aliases: []string{}},
+		WantErr:     uc_errors.ErrCreateService,
+		RepoErr:     errors.New("db error"),
+	},
+
+	{
+		Name: "success",
+		Input: dto.CreateService{
+			Name:    " Netflix ",
+			Aliases: []string{"netflix", "NFLX", " Netflix Inc ", "nflx"},
+		},
+		WantService: &entity.Service{Name: "Netflix", Aliases: []string{"NFLX", "Netflix Inc"}},
+		RepoOutput:  5,
+		Output:      dto.CreateServiceResponse{ID: 5},
+	},
+}
+
+func TestCreateServiceUC(t *testing.T) {
+	for _, tt := range CreateServiceCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.ServiceRepository)
+			uc := &CreateServiceUC{Services: repo}
+
+			repo.On("Resolve", mock.Anything, mock.Anything).
+				Return(func(_ context.Context, name string) (*entity.Service, error) {
+					if s, ok := tt.Taken[name]; ok {
+						return s, nil
+					}
+					return nil, sql.ErrNoRows
+				}).Maybe()
+
+			if tt.WantService != nil {
+				repo.On("Create", mock.Anything, tt.WantService).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -2,6 +2,10 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/maket12/SubTrack/internal/app/dto"
@@ -14,6 +18,17 @@ import (
 
 type CreateSubscriptionUC struct {
 	Subscriptions port.SubscriptionRepository
+	Services      port.ServiceRepository
+	Vendors       port.VendorRepository
+	Charges       port.ChargeRepository
+	Budgets       port.BudgetRepository
+	Rates         port.ExchangeRateRepository
+	Notifier      port.BudgetNotifier
+	Log           *slog.Logger
+
+	// RequireApproval creates subscriptions pending approval; they are not
+	// charged until approved.
+	RequireApproval bool
 }
 
 func (uc *CreateSubscriptionUC) Execute(ctx context.Context, in dto.CreateSubscription) (dto.CreateSubscriptionResponse, error) {
@@ -21,11 +36,20 @@ func (uc *CreateSubscriptionUC) Execute(ctx context.Context, in dto.CreateSubscr
 	   #	Validation    #
 	   ####################
 	*/
-	if in.ServiceName == "" {
-		return dto.CreateSubscriptionResponse{}, uc_errors.ErrEmptyServiceName
+	// every problem with the input is collected, not just the first one
+	errs := &entity.ValidationError{}
+
+	if in.ServiceID != nil && *in.ServiceID <= 0 {
+		errs.Add("service_id", entity.CodeInvalid, uc_errors.ErrInvalidServiceID)
+	}
+	if in.ServiceID == nil && strings.TrimSpace(in.ServiceName) == "" {
+		errs.Add("service_name", entity.CodeRequired, uc_errors.ErrEmptyServiceName)
 	}
 	if in.UserID == "" {
-		return dto.CreateSubscriptionResponse{}, uc_errors.ErrEmptyUserID
+		errs.Add("user_id", entity.CodeRequired, uc_errors.ErrEmptyUserID)
+	}
+	if in.VendorID != nil && *in.VendorID <= 0 {
+		errs.Add("vendor_id", entity.CodeInvalid, uc_errors.ErrInvalidVendorID)
 	}
 
 	/* ####################
@@ -34,39 +58,141 @@ func (uc *CreateSubscriptionUC) Execute(ctx context.Context, in dto.CreateSubscr
 	*/
 	uid, err := uuid.Parse(in.UserID)
 	if err != nil {
-		return dto.CreateSubscriptionResponse{}, uc_errors.ErrInvalidUserID
+		errs.Add("user_id", entity.CodeInvalid, uc_errors.ErrInvalidUserID)
 	}
 
 	start, err := time.Parse("02-01-2006", in.StartDate)
 	if err != nil {
-		return dto.CreateSubscriptionResponse{}, uc_errors.ErrInvalidDate
+		errs.Add("start_date", entity.CodeInvalid, uc_errors.ErrInvalidDate)
 	}
 
 	var end *time.Time
 	if in.EndDate != nil {
 		t, err := time.Parse("02-01-2006", *in.EndDate)
 		if err != nil {
-			return dto.CreateSubscriptionResponse{}, uc_errors.ErrInvalidDate
+			errs.Add("end_date", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+		} else {
+			end = &t
+		}
+	}
+
+	var trialEnd *time.Time
+	if in.TrialEndDate != nil {
+		t, err := time.Parse("02-01-2006", *in.TrialEndDate)
+		if err != nil {
+			errs.Add("trial_end_date", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+		} else {
+			trialEnd = &t
+		}
+	}
+
+	seats := 1
+	if in.Seats != nil {
+		seats = *in.Seats
+	}
+
+	var trialPrice int
+	if in.TrialPrice != nil {
+		trialPrice = *in.TrialPrice
+	}
+
+	currency := entity.DefaultCurrency
+	if in.Currency != nil {
+		// an unknown code is reported by the subscription itself
+		currency, _ = entity.ParseCurrency(*in.Currency)
+	}
+
+	period := entity.BillingPeriodMonthly
+	if in.BillingPeriod != nil {
+		period = entity.BillingPeriod(*in.BillingPeriod)
+	}
+
+	tags, err := cleanTags(in.Tags)
+	if err != nil {
+		errs.Add("tags", entity.CodeInvalid, err)
+	}
+
+	var renewalOwner *uuid.UUID
+	if in.RenewalOwnerID != nil && *in.RenewalOwnerID != "" {
+		owner, err := uuid.Parse(*in.RenewalOwnerID)
+		if err != nil || owner == uuid.Nil {
+			errs.Add("renewal_owner_id", entity.CodeInvalid, uc_errors.ErrInvalidRenewalOwnerID)
+		} else {
+			renewalOwner = &owner
 		}
-		end = &t
+	}
+
+	// the service is set once resolved from the catalog
+	sub, err := entity.NewSubscription(entity.Subscription{
+		Category:      cleanCategory(in.Category),
+		Tags:          tags,
+		Price:         in.Price,
+		Seats:         seats,
+		Currency:      currency,
+		BillingPeriod: period,
+		UserID:        uid,
+		StartDate:     start,
+		EndDate:       end,
+		TrialEndDate:  trialEnd,
+		TrialPrice:    trialPrice,
+		VendorID:      in.VendorID,
+
+		MinimumTermMonths: in.MinimumTermMonths,
+		NoticePeriodDays:  in.NoticePeriodDays,
+		RenewalOwner:      renewalOwner,
+	})
+	errs.Merge(err)
+
+	if err := errs.Err(); err != nil {
+		return dto.CreateSubscriptionResponse{}, err
 	}
 
 	/* ####################
 	   #	 Request      #
 	   ####################
 	*/
-	sub := &entity.Subscription{
-		ServiceName: in.ServiceName,
-		Price:       in.Price,
-		UserID:      uid,
-		StartDate:   start,
-		EndDate:     end,
+	if in.VendorID != nil {
+		if err := checkVendor(ctx, uc.Vendors, *in.VendorID); err != nil {
+			return dto.CreateSubscriptionResponse{}, err
+		}
+	}
+
+	service, err := resolveService(ctx, uc.Services, in.ServiceID, in.ServiceName)
+	if err != nil {
+		return dto.CreateSubscriptionResponse{}, err
+	}
+	sub.ServiceID = &service.ID
+	sub.ServiceName = service.Name
+
+	sub.Approval = entity.ApprovalApproved
+	if uc.RequireApproval {
+		sub.Approval = entity.ApprovalPending
+	}
+
+	if !in.AllowOverlap {
+		conflictingID, err := uc.Subscriptions.FindOverlap(ctx, sub)
+		if err == nil {
+			return dto.CreateSubscriptionResponse{}, &uc_errors.OverlapError{ConflictingID: conflictingID}
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return dto.CreateSubscriptionResponse{}, uc_errors.Wrap(uc_errors.ErrCheckOverlap, err)
+		}
 	}
 
 	id, err := uc.Subscriptions.Create(ctx, sub)
 	if err != nil {
 		return dto.CreateSubscriptionResponse{}, uc_errors.Wrap(uc_errors.ErrCreateSubscription, err)
 	}
+	sub.ID = id
+
+	syncCharges(ctx, uc.Log, uc.Charges, id)
+
+	// budgets are checked once the new spend counts, on approval
+	if sub.Approval == entity.ApprovalPending {
+		return dto.CreateSubscriptionResponse{ID: id, PendingApproval: true}, nil
+	}
+
+	warnings := checkBudgets(ctx, uc.Log, uc.Budgets, uc.Services, uc.Subscriptions, uc.Rates, uc.Notifier, sub)
 
-	return dto.CreateSubscriptionResponse{ID: id}, nil
+	return dto.CreateSubscriptionResponse{ID: id, Warnings: warnings}, nil
 }
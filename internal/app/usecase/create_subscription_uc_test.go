@@ -1,12 +1,17 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
 	"errors"
+	"log/slog"
 
 	"github.com/google/uuid"
 	"github.com/maket12/SubTrack/internal/app/dto"
 	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
 	"github.com/maket12/SubTrack/internal/domain/port/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,6 +26,7 @@ type CreateCase struct {
 	Output     dto.CreateSubscriptionResponse
 	WantErr    error
 	RepoErr    error
+	SyncErr    error
 }
 
 var CreateCases = []CreateCase{
@@ -84,6 +90,79 @@ var CreateCases = []CreateCase{
 		WantErr: uc_errors.ErrInvalidDate,
 	},
 
+	{
+		Name: "invalid trial end date",
+		Input: dto.CreateSubscription{
+			ServiceName:  "Okko",
+			Price:        399,
+			UserID:       uuid.New().String(),
+			StartDate:    "01-01-2025",
+			TrialEndDate: vPtr("2025-01-14"),
+		},
+		WantErr: uc_errors.ErrInvalidDate,
+	},
+
+	{
+		Name: "trial ends before start",
+		Input: dto.CreateSubscription{
+			ServiceName:  "Okko",
+			Price:        399,
+			UserID:       uuid.New().String(),
+			StartDate:    "01-01-2025",
+			TrialEndDate: vPtr("31-12-2024"),
+		},
+		WantErr: entity.ErrTrialBeforeStart,
+	},
+
+	{
+		Name: "end before start",
+		Input: dto.CreateSubscription{
+			ServiceName: "Okko",
+			Price:       399,
+			UserID:      uuid.New().String(),
+			StartDate:   "01-01-2025",
+			EndDate:     vPtr("31-12-2024"),
+		},
+		WantErr: entity.ErrEndBeforeStart,
+	},
+
+	{
+		Name: "negative price",
+		Input: dto.CreateSubscription{
+			ServiceName: "Okko",
+			Price:       -399,
+			UserID:      uuid.New().String(),
+			StartDate:   "01-01-2025",
+		},
+		WantErr: entity.ErrNegativePrice,
+	},
+
+	{
+		Name: "invalid currency",
+		Input: dto.CreateSubscription{
+			ServiceName: "Spotify",
+			Price:       10,
+			Currency:    vPtr("XYZ"),
+			UserID:      uuid.New().String(),
+			StartDate:   "01-01-2025",
+			EndDate:     nil,
+		},
+		WantErr: entity.ErrUnknownCurrency,
+	},
+
+	{
+		Name: "invalid billing period",
+		Input: dto.CreateSubscription{
+			ServiceName:   "JetBrains",
+			Price:         12000,
+			BillingPeriod: vPtr("biweekly"),
+			UserID:        uuid.New().String(),
+			StartDate:     "01-01-2025",
+			EndDate:       nil,
+		},
+		WantErr: entity.ErrUnknownPeriod,
+	},
+
 	{
 		Name: "repository error",
 		Input: dto.CreateSubscription{
@@ -97,6 +176,31 @@ var CreateCases = []CreateCase{
 		RepoErr: errors.New("db error"),
 	},
 
+	{
+		Name: "empty tag",
+		Input: dto.CreateSubscription{
+			ServiceName: "Figma",
+			Price:       144,
+			UserID:      uuid.New().String(),
+			StartDate:   "01-01-2025",
+			Tags:        []string{""},
+		},
+		WantErr: uc_errors.ErrEmptyTag,
+	},
+
+	{
+		Name: "charges ledger error keeps the write",
+		Input: dto.CreateSubscription{
+			ServiceName: "Alfa+",
+			Price:       1500,
+			UserID:      uuid.New().String(),
+			StartDate:   "27-11-2025",
+		},
+		RepoOutput: 7,
+		Output:     dto.CreateSubscriptionResponse{ID: 7},
+		SyncErr:    errors.New("db error"),
+	},
+
 	{
 		Name: "success create",
 		Input: dto.CreateSubscription{
@@ -111,26 +215,84 @@ var CreateCases = []CreateCase{
 		WantErr:    nil,
 		RepoErr:    nil,
 	},
+
+	{
+		Name: "success create with trial",
+		Input: dto.CreateSubscription{
+			ServiceName:  "Okko",
+			Price:        399,
+			UserID:       uuid.New().String(),
+			StartDate:    "01-01-2025",
+			TrialEndDate: vPtr("14-01-2025"),
+			TrialPrice:   vPtr(1),
+		},
+		RepoOutput: 3,
+		Output:     dto.CreateSubscriptionResponse{ID: 3},
+	},
+
+	{
+		Name: "success create yearly",
+		Input: dto.CreateSubscription{
+			ServiceName:   "Figma",
+			Price:         144,
+			Currency:      vPtr("usd"),
+			BillingPeriod: vPtr("yearly"),
+			UserID:        uuid.New().String(),
+			StartDate:     "01-01-2025",
+			EndDate:       nil,
+		},
+		RepoOutput: 2,
+		Output:     dto.CreateSubscriptionResponse{ID: 2},
+		WantErr:    nil,
+		RepoErr:    nil,
+	},
 }
 
 func vPtr[T any](v T) *T {
 	return &v
 }
 
+// testLog discards what use cases log about failures they recover from.
+var testLog = slog.New(slog.DiscardHandler)
+
 func TestCreateSubscriptionUC(t *testing.T) {
 	for _, tt := range CreateCases {
 		t.Run(tt.Name, func(t *testing.T) {
 			repo := new(mocks.SubscriptionRepository)
-			uc := &CreateSubscriptionUC{Subscriptions: repo}
+			charges := new(mocks.ChargeRepository)
+			services := new(mocks.ServiceRepository)
+			budgets := new(mocks.BudgetRepository)
+			uc := &CreateSubscriptionUC{
+				Subscriptions: repo,
+				Services:      services,
+				Charges:       charges,
+				Budgets:       budgets,
+				Log:           testLog,
+			}
 
 			shouldCallRepo :=
 				tt.WantErr == nil ||
 					errors.Is(tt.WantErr, uc_errors.ErrCreateSubscription)
 
+			shouldSync :=
+				tt.WantErr == nil
+
 			if shouldCallRepo {
+				services.On("Resolve", mock.Anything, tt.Input.ServiceName).
+					Return(&entity.Service{ID: 1, Name: tt.Input.ServiceName}, nil)
+				repo.On("FindOverlap", mock.Anything, mock.Anything).
+					Return(0, sql.ErrNoRows)
 				repo.On("Create", mock.Anything, mock.Anything).
 					Return(tt.RepoOutput, tt.RepoErr)
 			}
+			if shouldSync {
+				charges.On("Sync", mock.Anything, tt.RepoOutput, ledgerHorizon()).
+					Return(tt.SyncErr)
+			}
+			if tt.WantErr == nil {
+				budgets.On("GetList", mock.Anything, mock.Anything).
+					Return([]entity.Budget(nil), nil)
+			}
 
 			resp, err := uc.Execute(context.Background(), tt.Input)
 
@@ -144,6 +306,555 @@ func TestCreateSubscriptionUC(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+			budgets.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateSubscriptionUC_AllFieldErrors(t *testing.T) {
+	uc := &CreateSubscriptionUC{
+		Subscriptions: new(mocks.SubscriptionRepository),
+		Services:      new(mocks.ServiceRepository),
+	}
+
+	_, err := uc.Execute(context.Background(), dto.CreateSubscription{
+		ServiceName: " ",
+		Price:       -1,
+		Currency:    vPtr("XYZ"),
+		UserID:      "not-a-uuid",
+		StartDate:   "01-01-2025",
+		EndDate:     vPtr("31-12-2024"),
+	})
+
+	var verr *entity.ValidationError
+	assert.True(t, errors.As(err, &verr), "expected a validation error, got '%v'", err)
+	assert.Equal(t, []entity.FieldError{
+		{Field: "service_name", Code: entity.CodeRequired, Err: uc_errors.ErrEmptyServiceName},
+		{Field: "user_id", Code: entity.CodeInvalid, Err: uc_errors.ErrInvalidUserID},
+		{Field: "price", Code: entity.CodeNegative, Err: entity.ErrNegativePrice},
+		{Field: "currency", Code: entity.CodeInvalid, Err: entity.ErrUnknownCurrency},
+		{Field: "end_date", Code: entity.CodeBeforeStart, Err: entity.ErrEndBeforeStart},
+	}, verr.Fields)
+}
+
+func TestCreateSubscriptionUC_RequireApproval(t *testing.T) {
+	repo := new(mocks.SubscriptionRepository)
+	charges := new(mocks.ChargeRepository)
+	services := new(mocks.ServiceRepository)
+	budgets := new(mocks.BudgetRepository)
+	uc := &CreateSubscriptionUC{
+		Subscriptions:   repo,
+		Services:        services,
+		Charges:         charges,
+		Budgets:         budgets,
+		RequireApproval: true,
+	}
+
+	services.On("Resolve", mock.Anything, "Notion").
+		Return(&entity.Service{ID: 1, Name: "Notion"}, nil)
+	repo.On("FindOverlap", mock.Anything, mock.Anything).
+		Return(0, sql.ErrNoRows)
+	repo.On("Create", mock.Anything, mock.MatchedBy(func(s *entity.Subscription) bool {
+		return s.Approval == entity.ApprovalPending
+	})).Return(9, nil)
+	charges.On("Sync", mock.Anything, 9, ledgerHorizon()).
+		Return(nil)
+
+	resp, err := uc.Execute(context.Background(), dto.CreateSubscription{
+		ServiceName: "Notion",
+		Price:       800,
+		UserID:      uuid.New().String(),
+		StartDate:   "01-01-2025",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, dto.CreateSubscriptionResponse{ID: 9, PendingApproval: true}, resp)
+
+	repo.AssertExpectations(t)
+	charges.AssertExpectations(t)
+	// the pending spend does not count against budgets yet
+	budgets.AssertNotCalled(t, "GetList", mock.Anything, mock.Anything)
+}
+
+type CreateBudgetCheckCase struct {
+	Name       string
+	Budgets    []entity.Budget
+	BudgetsErr error
+	// Spent is this month's spend per budget id.
+	Spent   map[int]int
+	Output  dto.CreateSubscriptionResponse
+	WantErr error
+	// WantLog is logged when the check fails.
+	WantLog string
+}
+
+func TestCreateSubscriptionUC_Budgets(t *testing.T) {
+	uid := uuid.New()
+	month := currentMonth()
+
+	cases := []CreateBudgetCheckCase{
+		{
+			Name: "within budget",
+			Budgets: []entity.Budget{
+				{ID: 1, UserID: uid, MonthlyLimit: 1000, Currency: "RUB"},
+			},
+			Spent:  map[int]int{1: 1000},
+			Output: dto.CreateSubscriptionResponse{ID: 7},
+		},
+
+		{
+			Name: "over budget",
+			Budgets: []entity.Budget{
+				{ID: 1, UserID: uid, MonthlyLimit: 1000, Currency: "RUB"},
+				{ID: 2, UserID: uid, ServiceName: vPtr("Spotify"), MonthlyLimit: 100, Currency: "RUB"},
+				{ID: 3, UserID: uid, ServiceName: vPtr("Netflix"), MonthlyLimit: 500, Currency: "RUB"},
+				{ID: 4, UserID: uid, Category: vPtr("cloud"), MonthlyLimit: 100, Currency: "RUB"},
+				{ID: 5, UserID: uid, Category: vPtr("streaming"), MonthlyLimit: 700, Currency: "RUB"},
+			},
+			Spent: map[int]int{1: 900, 3: 800, 5: 800},
+			Output: dto.CreateSubscriptionResponse{
+				ID: 7,
+				Warnings: []dto.BudgetWarning{
+					{
+						BudgetID:    3,
+						ServiceName: vPtr("Netflix"),
+						Month:       month.Format("01-2006"),
+						Limit:       dto.Money{Amount: 500, Currency: "RUB"},
+						Spent:       dto.Money{Amount: 800, Currency: "RUB"},
+					},
+					{
+						BudgetID: 5,
+						Category: vPtr("streaming"),
+						Month:    month.Format("01-2006"),
+						Limit:    dto.Money{Amount: 700, Currency: "RUB"},
+						Spent:    dto.Money{Amount: 800, Currency: "RUB"},
+					},
+				},
+			},
+		},
+
+		{
+			Name: "over budget on service alias",
+			Budgets: []entity.Budget{
+				{ID: 1, UserID: uid, ServiceName: vPtr("netflix premium"), MonthlyLimit: 500, Currency: "RUB"},
+			},
+			Spent: map[int]int{1: 800},
+			Output: dto.CreateSubscriptionResponse{
+				ID: 7,
+				Warnings: []dto.BudgetWarning{
+					{
+						BudgetID:    1,
+						ServiceName: vPtr("netflix premium"),
+						Month:       month.Format("01-2006"),
+						Limit:       dto.Money{Amount: 500, Currency: "RUB"},
+						Spent:       dto.Money{Amount: 800, Currency: "RUB"},
+					},
+				},
+			},
+		},
+
+		{
+			Name:       "budgets error keeps the subscription",
+			BudgetsErr: errors.New("db error"),
+			Output:     dto.CreateSubscriptionResponse{ID: 7},
+			WantLog:    "subscription_id=7",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			services := new(mocks.ServiceRepository)
+			budgets := new(mocks.BudgetRepository)
+			notifier := new(mocks.BudgetNotifier)
+			var logged bytes.Buffer
+			uc := &CreateSubscriptionUC{
+				Subscriptions: repo,
+				Services:      services,
+				Charges:       charges,
+				Budgets:       budgets,
+				Notifier:      notifier,
+				Log:           slog.New(slog.NewTextHandler(&logged, nil)),
+			}
+
+			services.On("Resolve", mock.Anything, "Netflix").
+				Return(&entity.Service{ID: 1, Name: "Netflix", Aliases: []string{"Netflix Premium"}}, nil)
+			services.On("Get", mock.Anything, 1).
+				Return(&entity.Service{ID: 1, Name: "Netflix", Aliases: []string{"Netflix Premium"}}, nil).
+				Maybe()
+			repo.On("FindOverlap", mock.Anything, mock.Anything).Return(0, sql.ErrNoRows)
+			repo.On("Create", mock.Anything, mock.Anything).Return(7, nil)
+			charges.On("Sync", mock.Anything, 7, ledgerHorizon()).Return(nil)
+			budgets.On("GetList", mock.Anything, filter.BudgetFilter{UserID: &uid}).
+				Return(tt.Budgets, tt.BudgetsErr)
+
+			for _, b := range tt.Budgets {
+				spent, ok := tt.Spent[b.ID]
+				if !ok {
+					continue
+				}
+				repo.On("GetMonthlySums", mock.Anything, mock.MatchedBy(func(f filter.SumFilter) bool {
+					return f.StartDate.Equal(month) &&
+						assert.ObjectsAreEqual(b.ServiceName, f.ServiceName) &&
+						assert.ObjectsAreEqual(b.Category, f.Category)
+				})).Return([]entity.MonthlyAmount{
+					{Month: month, Money: entity.Money{Amount: spent, Currency: "RUB"}},
+				}, nil).Once()
+
+				if spent > b.MonthlyLimit {
+					notifier.On("BudgetExceeded", mock.Anything, entity.BudgetAlert{
+						Budget: b,
+						Month:  month,
+						Spent:  entity.Money{Amount: spent, Currency: "RUB"},
+					}).Return()
+				}
+			}
+
+			resp, err := uc.Execute(context.Background(), dto.CreateSubscription{
+				ServiceName: "Netflix",
+				Category:    vPtr("Streaming"),
+				Price:       800,
+				UserID:      uid.String(),
+				StartDate:   month.Format("02-01-2006"),
+			})
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+			if tt.WantLog != "" {
+				assert.Contains(t, logged.String(), tt.WantLog)
+			}
+
+			repo.AssertExpectations(t)
+			budgets.AssertExpectations(t)
+			notifier.AssertExpectations(t)
+		})
+	}
+}
+
+type CreateServiceResolveCase struct {
+	Name        string
+	ServiceID   *int
+	ServiceName string
+	GetOutput   *entity.Service
+	GetErr      error
+	Resolved    *entity.Service
+	ResolveErr  error
+	WantCreate  *entity.Service
+	WantSub     *entity.Service
+	WantErr     error
+}
+
+func TestCreateSubscriptionUC_Service(t *testing.T) {
+	uid := uuid.New()
+
+	cases := []CreateServiceResolveCase{
+		{
+			Name:        "alias resolves to canonical name",
+			ServiceName: "nflx",
+			Resolved:    &entity.Service{ID: 3, Name: "Netflix"},
+			WantSub:     &entity.Service{ID: 3, Name: "Netflix"},
+		},
+
+		{
+			Name:        "unknown name joins the catalog",
+			ServiceName: " Kinopoisk ",
+			ResolveErr:  sql.ErrNoRows,
+			WantCreate:  &entity.Service{Name: "Kinopoisk"},
+			WantSub:     &entity.Service{ID: 9, Name: "Kinopoisk"},
+		},
+
+		{
+			Name:      "by id",
+			ServiceID: vPtr(3),
+			GetOutput: &entity.Service{ID: 3, Name: "Netflix"},
+			WantSub:   &entity.Service{ID: 3, Name: "Netflix"},
+		},
+
+		{
+			Name:      "id not found",
+			ServiceID: vPtr(3),
+			GetErr:    sql.ErrNoRows,
+			WantErr:   uc_errors.ErrServiceNotFound,
+		},
+
+		{
+			Name:      "invalid id",
+			ServiceID: vPtr(0),
+			WantErr:   uc_errors.ErrInvalidServiceID,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			services := new(mocks.ServiceRepository)
+			budgets := new(mocks.BudgetRepository)
+			uc := &CreateSubscriptionUC{
+				Subscriptions: repo,
+				Services:      services,
+				Charges:       charges,
+				Budgets:       budgets,
+			}
+
+			if tt.GetOutput != nil || tt.GetErr != nil {
+				services.On("Get", mock.Anything, *tt.ServiceID).Return(tt.GetOutput, tt.GetErr)
+			}
+			if tt.Resolved != nil || tt.ResolveErr != nil {
+				services.On("Resolve", mock.Anything, mock.Anything).Return(tt.Resolved, tt.ResolveErr)
+			}
+			if tt.WantCreate != nil {
+				services.On("Create", mock.Anything, tt.WantCreate).Return(tt.WantSub.ID, nil)
+			}
+			if tt.WantSub != nil {
+				repo.On("FindOverlap", mock.Anything, mock.Anything).Return(0, sql.ErrNoRows)
+				repo.On("Create", mock.Anything, mock.MatchedBy(func(s *entity.Subscription) bool {
+					return s.ServiceID != nil && *s.ServiceID == tt.WantSub.ID &&
+						s.ServiceName == tt.WantSub.Name
+				})).Return(7, nil)
+				charges.On("Sync", mock.Anything, 7, ledgerHorizon()).Return(nil)
+				budgets.On("GetList", mock.Anything, filter.BudgetFilter{UserID: &uid}).
+					Return([]entity.Budget{}, nil)
+			}
+
+			_, err := uc.Execute(context.Background(), dto.CreateSubscription{
+				ServiceID:   tt.ServiceID,
+				ServiceName: tt.ServiceName,
+				Price:       800,
+				UserID:      uid.String(),
+				StartDate:   "01-01-2025",
+			})
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+			services.AssertExpectations(t)
+		})
+	}
+}
+
+type CreateOverlapCase struct {
+	Name         string
+	AllowOverlap bool
+	ConflictID   int
+	FindErr      error
+	WantCreate   bool
+	WantErr      error
+}
+
+func TestCreateSubscriptionUC_Overlap(t *testing.T) {
+	uid := uuid.New()
+
+	cases := []CreateOverlapCase{
+		{
+			Name:       "overlaps existing subscription",
+			ConflictID: 12,
+			WantErr:    uc_errors.ErrSubscriptionOverlap,
+		},
+
+		{
+			Name:    "overlap check error",
+			FindErr: errors.New("db error"),
+			WantErr: uc_errors.ErrCheckOverlap,
+		},
+
+		{
+			Name:       "no overlap",
+			FindErr:    sql.ErrNoRows,
+			WantCreate: true,
+		},
+
+		{
+			Name:         "overlap allowed",
+			AllowOverlap: true,
+			WantCreate:   true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			services := new(mocks.ServiceRepository)
+			budgets := new(mocks.BudgetRepository)
+			uc := &CreateSubscriptionUC{
+				Subscriptions: repo,
+				Services:      services,
+				Charges:       charges,
+				Budgets:       budgets,
+			}
+
+			services.On("Resolve", mock.Anything, "Spotify").
+				Return(&entity.Service{ID: 1, Name: "Spotify"}, nil)
+
+			if !tt.AllowOverlap {
+				repo.On("FindOverlap", mock.Anything, mock.MatchedBy(func(s *entity.Subscription) bool {
+					return s.UserID == uid && s.ServiceName == "Spotify" && s.EndDate == nil
+				})).Return(tt.ConflictID, tt.FindErr)
+			}
+			if tt.WantCreate {
+				repo.On("Create", mock.Anything, mock.Anything).Return(7, nil)
+				charges.On("Sync", mock.Anything, 7, ledgerHorizon()).Return(nil)
+				budgets.On("GetList", mock.Anything, mock.Anything).Return([]entity.Budget(nil), nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), dto.CreateSubscription{
+				ServiceName:  "Spotify",
+				Price:        300,
+				UserID:       uid.String(),
+				StartDate:    "01-01-2025",
+				AllowOverlap: tt.AllowOverlap,
+			})
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+
+				var overlap *uc_errors.OverlapError
+				if errors.As(err, &overlap) {
+					assert.Equal(t, tt.ConflictID, overlap.ConflictingID)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.CreateSubscriptionResponse{ID: 7}, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+type CreateContractCase struct {
+	Name      string
+	Input     dto.CreateSubscription
+	VendorErr error
+	WantSub   func(s *entity.Subscription) bool
+	WantErr   error
+}
+
+func TestCreateSubscriptionUC_Contract(t *testing.T) {
+	owner := uuid.New()
+	base := dto.CreateSubscription{
+		ServiceName: "Jira",
+		Price:       1000,
+		UserID:      uuid.New().String(),
+		StartDate:   "01-01-2025",
+	}
+	with := func(f func(in *dto.CreateSubscription)) dto.CreateSubscription {
+		in := base
+		f(&in)
+		return in
+	}
+
+	cases := []CreateContractCase{
+		{
+			Name:    "invalid vendor id",
+			Input:   with(func(in *dto.CreateSubscription) { in.VendorID = vPtr(0) }),
+			WantErr: uc_errors.ErrInvalidVendorID,
+		},
+
+		{
+			Name:    "invalid renewal owner id",
+			Input:   with(func(in *dto.CreateSubscription) { in.RenewalOwnerID = vPtr("not-a-uuid") }),
+			WantErr: uc_errors.ErrInvalidRenewalOwnerID,
+		},
+
+		{
+			Name:    "no minimum term",
+			Input:   with(func(in *dto.CreateSubscription) { in.MinimumTermMonths = vPtr(0) }),
+			WantErr: entity.ErrNoMinimumTerm,
+		},
+
+		{
+			Name:    "no notice period",
+			Input:   with(func(in *dto.CreateSubscription) { in.NoticePeriodDays = vPtr(0) }),
+			WantErr: entity.ErrNoNoticePeriod,
+		},
+
+		{
+			Name:      "vendor not found",
+			Input:     with(func(in *dto.CreateSubscription) { in.VendorID = vPtr(4) }),
+			VendorErr: sql.ErrNoRows,
+			WantErr:   uc_errors.ErrVendorNotFound,
+		},
+
+		{
+			Name: "success",
+			Input: with(func(in *dto.CreateSubscription) {
+				in.VendorID = vPtr(4)
+				in.MinimumTermMonths = vPtr(12)
+				in.NoticePeriodDays = vPtr(30)
+				in.RenewalOwnerID = vPtr(owner.String())
+			}),
+			WantSub: func(s *entity.Subscription) bool {
+				return *s.VendorID == 4 &&
+					*s.MinimumTermMonths == 12 &&
+					*s.NoticePeriodDays == 30 &&
+					*s.RenewalOwner == owner
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			services := new(mocks.ServiceRepository)
+			vendors := new(mocks.VendorRepository)
+			budgets := new(mocks.BudgetRepository)
+			uc := &CreateSubscriptionUC{
+				Subscriptions: repo,
+				Services:      services,
+				Vendors:       vendors,
+				Charges:       charges,
+				Budgets:       budgets,
+			}
+
+			if tt.VendorErr != nil || tt.WantSub != nil {
+				vendors.On("Get", mock.Anything, 4).
+					Return(&entity.Vendor{ID: 4, Name: "Atlassian"}, tt.VendorErr)
+			}
+			if tt.WantSub != nil {
+				services.On("Resolve", mock.Anything, "Jira").
+					Return(&entity.Service{ID: 1, Name: "Jira"}, nil)
+				repo.On("FindOverlap", mock.Anything, mock.Anything).
+					Return(0, sql.ErrNoRows)
+				repo.On("Create", mock.Anything, mock.MatchedBy(tt.WantSub)).
+					Return(5, nil)
+				charges.On("Sync", mock.Anything, 5, ledgerHorizon()).
+					Return(nil)
+				budgets.On("GetList", mock.Anything, mock.Anything).
+					Return([]entity.Budget(nil), nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.CreateSubscriptionResponse{ID: 5}, resp)
+			}
+
+			repo.AssertExpectations(t)
+			vendors.AssertExpectations(t)
 		})
 	}
 }
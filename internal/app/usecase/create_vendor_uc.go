@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type CreateVendorUC struct {
+	Vendors port.VendorRepository
+}
+
+func (uc *CreateVendorUC) Execute(ctx context.Context, in dto.CreateVendor) (dto.CreateVendorResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	v := &entity.Vendor{
+		Name:           in.Name,
+		Contact:        in.Contact,
+		AccountManager: in.AccountManager,
+		Notes:          in.Notes,
+	}
+	if err := cleanVendor(v); err != nil {
+		return dto.CreateVendorResponse{}, err
+	}
+
+	if err := checkVendorName(ctx, uc.Vendors, 0, v.Name); err != nil {
+		return dto.CreateVendorResponse{}, err
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	id, err := uc.Vendors.Create(ctx, v)
+	if err != nil {
+		return dto.CreateVendorResponse{}, uc_errors.Wrap(uc_errors.ErrCreateVendor, err)
+	}
+
+	return dto.CreateVendorResponse{ID: id}, nil
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type CreateVendorCase struct {
+	Name       string
+	Input      dto.CreateVendor
+	Taken      *entity.Vendor
+	WantVendor *entity.Vendor
+	RepoOutput int
+	Output     dto.CreateVendorResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var CreateVendorCases = []CreateVendorCase{
+	{
+		Name:    "empty name",
+		Input:   dto.CreateVendor{Name: "  "},
+		WantErr: uc_errors.ErrEmptyVendorName,
+	},
+
+	{
+		Name:    "name taken",
+		Input:   dto.CreateVendor{Name: "atlassian"},
+		Taken:   &entity.Vendor{ID: 2, Name: "Atlassian"},
+		WantErr: uc_errors.ErrVendorNameTaken,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.CreateVendor{Name: "Atlassian"},
+		WantVendor: &entity.Vendor{Name: "Atlassian"},
+		WantErr:    uc_errors.ErrCreateVendor,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success drops blank details",
+		Input:      dto.CreateVendor{Name: " Atlassian ", Contact: vPtr(" "), Notes: vPtr("")},
+		WantVendor: &entity.Vendor{Name: "Atlassian"},
+		RepoOutput: 3,
+		Output:     dto.CreateVendorResponse{ID: 3},
+	},
+
+	{
+		Name: "success with details",
+		Input: dto.CreateVendor{
+			Name:           "Adobe",
+			Contact:        vPtr(" billing@adobe.com "),
+			AccountManager: vPtr("Jane Roe"),
+			Notes:          vPtr("annual contract"),
+		},
+		WantVendor: &entity.Vendor{
+			Name:           "Adobe",
+			Contact:        vPtr("billing@adobe.com"),
+			AccountManager: vPtr("Jane Roe"),
+			Notes:          vPtr("annual contract"),
+		},
+		RepoOutput: 4,
+		Output:     dto.CreateVendorResponse{ID: 4},
+	},
+}
+
+func TestCreateVendorUC(t *testing.T) {
+	for _, tt := range CreateVendorCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.VendorRepository)
+			uc := &CreateVendorUC{Vendors: repo}
+
+			repo.On("FindByName", mock.Anything, mock.Anything).
+				Return(func(context.Context, string) (*entity.Vendor, error) {
+					if tt.Taken != nil {
+						return tt.Taken, nil
+					}
+					return nil, sql.ErrNoRows
+				}).Maybe()
+
+			if tt.WantVendor != nil {
+				repo.On("Create", mock.Anything, tt.WantVendor).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
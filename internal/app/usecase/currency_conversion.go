@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// convertMonthlySums adds up monthly amounts in the target currency, converting
+// every month at the latest rate known by the end of that month.
+func convertMonthlySums(
+	ctx context.Context,
+	rates port.ExchangeRateRepository,
+	sums []entity.MonthlyAmount,
+	target entity.Currency,
+) (entity.Money, error) {
+	var total float64
+
+	for _, s := range sums {
+		rate, err := monthlyRate(ctx, rates, s.Currency, target, s.Month)
+		if err != nil {
+			return entity.Money{}, err
+		}
+
+		total += float64(s.Amount) * rate
+	}
+
+	return entity.Money{Amount: int(math.Round(total)), Currency: target}, nil
+}
+
+// monthlyRate is the latest rate from one currency into target known by the
+// end of the month starting at month.
+func monthlyRate(
+	ctx context.Context,
+	rates port.ExchangeRateRepository,
+	from, target entity.Currency,
+	month time.Time,
+) (float64, error) {
+	if from == target {
+		return 1, nil
+	}
+
+	monthEnd := month.AddDate(0, 1, -1)
+	rate, err := rates.GetRate(ctx, from, target, monthEnd)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, uc_errors.Wrap(uc_errors.ErrExchangeRateNotFound, err)
+		}
+		return 0, uc_errors.Wrap(uc_errors.ErrGetExchangeRate, err)
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import "time"
+
+// today is the current date in UTC, the way dates are stored.
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// parseDayOrToday parses an optional DD-MM-YYYY date, defaulting to today.
+func parseDayOrToday(in *string) (time.Time, error) {
+	if in == nil || *in == "" {
+		return today(), nil
+	}
+	return time.Parse("02-01-2006", *in)
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type DeleteBudgetUC struct {
+	Budgets port.BudgetRepository
+}
+
+func (uc *DeleteBudgetUC) Execute(ctx context.Context, in dto.DeleteBudget) (dto.DeleteBudgetResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.DeleteBudgetResponse{Deleted: false}, uc_errors.ErrInvalidBudgetID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	err := uc.Budgets.Delete(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.DeleteBudgetResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrBudgetNotFound, err)
+		}
+		return dto.DeleteBudgetResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrDeleteBudget, err)
+	}
+
+	return dto.DeleteBudgetResponse{Deleted: true}, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type DeleteBudgetCase struct {
+	Name    string
+	Input   dto.DeleteBudget
+	Output  dto.DeleteBudgetResponse
+	WantErr error
+	RepoErr error
+}
+
+var DeleteBudgetCases = []DeleteBudgetCase{
+	{
+		Name:    "invalid budget id",
+		Input:   dto.DeleteBudget{ID: 0},
+		WantErr: uc_errors.ErrInvalidBudgetID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.DeleteBudget{ID: 1},
+		WantErr: uc_errors.ErrBudgetNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.DeleteBudget{ID: 1},
+		WantErr: uc_errors.ErrDeleteBudget,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:    "success delete",
+		Input:   dto.DeleteBudget{ID: 1},
+		Output:  dto.DeleteBudgetResponse{Deleted: true},
+		WantErr: nil,
+		RepoErr: nil,
+	},
+}
+
+func TestDeleteBudgetUC(t *testing.T) {
+	for _, tt := range DeleteBudgetCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.BudgetRepository)
+			uc := &DeleteBudgetUC{Budgets: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrDeleteBudget) ||
+					errors.Is(tt.WantErr, uc_errors.ErrBudgetNotFound)
+
+			if shouldCallRepo {
+				repo.On("Delete", mock.Anything, mock.Anything).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type DeleteCostCentreUC struct {
+	CostCentres port.CostCentreRepository
+}
+
+func (uc *DeleteCostCentreUC) Execute(ctx context.Context, in dto.DeleteCostCentre) (dto.DeleteCostCentreResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.DeleteCostCentreResponse{Deleted: false}, uc_errors.ErrInvalidCostCentreID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	err := uc.CostCentres.Delete(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.DeleteCostCentreResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrCostCentreNotFound, err)
+		}
+		return dto.DeleteCostCentreResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrDeleteCostCentre, err)
+	}
+
+	return dto.DeleteCostCentreResponse{Deleted: true}, nil
+}
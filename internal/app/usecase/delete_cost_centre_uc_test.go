@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type DeleteCostCentreCase struct {
+	Name    string
+	Input   dto.DeleteCostCentre
+	Output  dto.DeleteCostCentreResponse
+	WantErr error
+	RepoErr error
+}
+
+var DeleteCostCentreCases = []DeleteCostCentreCase{
+	{
+		Name:    "invalid cost centre id",
+		Input:   dto.DeleteCostCentre{ID: 0},
+		WantErr: uc_errors.ErrInvalidCostCentreID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.DeleteCostCentre{ID: 1},
+		WantErr: uc_errors.ErrCostCentreNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.DeleteCostCentre{ID: 1},
+		WantErr: uc_errors.ErrDeleteCostCentre,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:   "success delete",
+		Input:  dto.DeleteCostCentre{ID: 1},
+		Output: dto.DeleteCostCentreResponse{Deleted: true},
+	},
+}
+
+func TestDeleteCostCentreUC(t *testing.T) {
+	for _, tt := range DeleteCostCentreCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.CostCentreRepository)
+			uc := &DeleteCostCentreUC{CostCentres: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrDeleteCostCentre) ||
+					errors.Is(tt.WantErr, uc_errors.ErrCostCentreNotFound)
+
+			if shouldCallRepo {
+				repo.On("Delete", mock.Anything, tt.Input.ID).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
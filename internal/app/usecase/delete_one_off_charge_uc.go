@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type DeleteOneOffChargeUC struct {
+	Charges port.OneOffChargeRepository
+}
+
+func (uc *DeleteOneOffChargeUC) Execute(ctx context.Context, in dto.DeleteOneOffCharge) (dto.DeleteOneOffChargeResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.DeleteOneOffChargeResponse{Deleted: false}, uc_errors.ErrInvalidOneOffChargeID
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	c, err := uc.Charges.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.DeleteOneOffChargeResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrOneOffChargeNotFound, err)
+		}
+		return dto.DeleteOneOffChargeResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrGetOneOffCharge, err)
+	}
+
+	// a termination fee goes away only with the contract change behind it
+	if c.TerminationFee {
+		return dto.DeleteOneOffChargeResponse{Deleted: false}, uc_errors.ErrTerminationFeeCharge
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	err = uc.Charges.Delete(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.DeleteOneOffChargeResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrOneOffChargeNotFound, err)
+		}
+		return dto.DeleteOneOffChargeResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrDeleteOneOffCharge, err)
+	}
+
+	return dto.DeleteOneOffChargeResponse{Deleted: true}, nil
+}
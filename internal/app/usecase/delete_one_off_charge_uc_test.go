@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type DeleteOneOffChargeCase struct {
+	Name    string
+	Input   dto.DeleteOneOffCharge
+	Output  dto.DeleteOneOffChargeResponse
+	Current *entity.OneOffCharge
+	GetErr  error
+	WantErr error
+	RepoErr error
+}
+
+var DeleteOneOffChargeCases = []DeleteOneOffChargeCase{
+	{
+		Name:    "invalid charge id",
+		Input:   dto.DeleteOneOffCharge{ID: 0},
+		WantErr: uc_errors.ErrInvalidOneOffChargeID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.DeleteOneOffCharge{ID: 1},
+		GetErr:  sql.ErrNoRows,
+		WantErr: uc_errors.ErrOneOffChargeNotFound,
+	},
+
+	{
+		Name:    "get error",
+		Input:   dto.DeleteOneOffCharge{ID: 1},
+		GetErr:  errors.New("db error"),
+		WantErr: uc_errors.ErrGetOneOffCharge,
+	},
+
+	{
+		Name:  "termination fee",
+		Input: dto.DeleteOneOffCharge{ID: 1},
+		Current: &entity.OneOffCharge{
+			ID:             1,
+			SubscriptionID: vPtr(7),
+			TerminationFee: true,
+		},
+		WantErr: uc_errors.ErrTerminationFeeCharge,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.DeleteOneOffCharge{ID: 1},
+		Current: currentOneOff(),
+		WantErr: uc_errors.ErrDeleteOneOffCharge,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:    "deleted meanwhile",
+		Input:   dto.DeleteOneOffCharge{ID: 1},
+		Current: currentOneOff(),
+		WantErr: uc_errors.ErrOneOffChargeNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "success delete",
+		Input:   dto.DeleteOneOffCharge{ID: 1},
+		Current: currentOneOff(),
+		Output:  dto.DeleteOneOffChargeResponse{Deleted: true},
+	},
+}
+
+func TestDeleteOneOffChargeUC(t *testing.T) {
+	for _, tt := range DeleteOneOffChargeCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.OneOffChargeRepository)
+			uc := &DeleteOneOffChargeUC{Charges: repo}
+
+			if tt.Current != nil || tt.GetErr != nil {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.Current, tt.GetErr)
+			}
+
+			if tt.Current != nil && !tt.Current.TerminationFee {
+				repo.On("Delete", mock.Anything, tt.Input.ID).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
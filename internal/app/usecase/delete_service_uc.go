@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type DeleteServiceUC struct {
+	Services port.ServiceRepository
+}
+
+func (uc *DeleteServiceUC) Execute(ctx context.Context, in dto.DeleteService) (dto.DeleteServiceResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.DeleteServiceResponse{Deleted: false}, uc_errors.ErrInvalidServiceID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	err := uc.Services.Delete(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.DeleteServiceResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrServiceNotFound, err)
+		}
+		return dto.DeleteServiceResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrDeleteService, err)
+	}
+
+	return dto.DeleteServiceResponse{Deleted: true}, nil
+}
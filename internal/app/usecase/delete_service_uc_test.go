@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type DeleteServiceCase struct {
+	Name    string
+	Input   dto.DeleteService
+	Output  dto.DeleteServiceResponse
+	WantErr error
+	RepoErr error
+}
+
+var DeleteServiceCases = []DeleteServiceCase{
+	{
+		Name:    "invalid service id",
+		Input:   dto.DeleteService{ID: 0},
+		WantErr: uc_errors.ErrInvalidServiceID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.DeleteService{ID: 1},
+		WantErr: uc_errors.ErrServiceNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.DeleteService{ID: 1},
+		WantErr: uc_errors.ErrDeleteService,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:    "success delete",
+		Input:   dto.DeleteService{ID: 1},
+		Output:  dto.DeleteServiceResponse{Deleted: true},
+		WantErr: nil,
+		RepoErr: nil,
+	},
+}
+
+func TestDeleteServiceUC(t *testing.T) {
+	for _, tt := range DeleteServiceCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.ServiceRepository)
+			uc := &DeleteServiceUC{Services: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrDeleteService) ||
+					errors.Is(tt.WantErr, uc_errors.ErrServiceNotFound)
+
+			if shouldCallRepo {
+				repo.On("Delete", mock.Anything, mock.Anything).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type DeleteVendorUC struct {
+	Vendors port.VendorRepository
+}
+
+func (uc *DeleteVendorUC) Execute(ctx context.Context, in dto.DeleteVendor) (dto.DeleteVendorResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.DeleteVendorResponse{Deleted: false}, uc_errors.ErrInvalidVendorID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	err := uc.Vendors.Delete(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.DeleteVendorResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrVendorNotFound, err)
+		}
+		return dto.DeleteVendorResponse{Deleted: false}, uc_errors.Wrap(uc_errors.ErrDeleteVendor, err)
+	}
+
+	return dto.DeleteVendorResponse{Deleted: true}, nil
+}
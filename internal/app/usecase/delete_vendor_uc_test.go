@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type DeleteVendorCase struct {
+	Name    string
+	Input   dto.DeleteVendor
+	Output  dto.DeleteVendorResponse
+	WantErr error
+	RepoErr error
+}
+
+var DeleteVendorCases = []DeleteVendorCase{
+	{
+		Name:    "invalid vendor id",
+		Input:   dto.DeleteVendor{ID: 0},
+		WantErr: uc_errors.ErrInvalidVendorID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.DeleteVendor{ID: 1},
+		WantErr: uc_errors.ErrVendorNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.DeleteVendor{ID: 1},
+		WantErr: uc_errors.ErrDeleteVendor,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:   "success delete",
+		Input:  dto.DeleteVendor{ID: 1},
+		Output: dto.DeleteVendorResponse{Deleted: true},
+	},
+}
+
+func TestDeleteVendorUC(t *testing.T) {
+	for _, tt := range DeleteVendorCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.VendorRepository)
+			uc := &DeleteVendorUC{Vendors: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrDeleteVendor) ||
+					errors.Is(tt.WantErr, uc_errors.ErrVendorNotFound)
+
+			if shouldCallRepo {
+				repo.On("Delete", mock.Anything, tt.Input.ID).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
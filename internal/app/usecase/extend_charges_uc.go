@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// ExtendChargesUC moves the charges ledger horizon forward, so that open-ended
+// subscriptions keep a year of charges ahead of today.
+type ExtendChargesUC struct {
+	Charges port.ChargeRepository
+}
+
+func (uc *ExtendChargesUC) Execute(ctx context.Context) (dto.ExtendChargesResponse, error) {
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	added, err := uc.Charges.Extend(ctx, ledgerHorizon())
+	if err != nil {
+		return dto.ExtendChargesResponse{}, uc_errors.Wrap(uc_errors.ErrExtendCharges, err)
+	}
+
+	return dto.ExtendChargesResponse{Added: added}, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ExtendChargesCase struct {
+	Name       string
+	RepoOutput int
+	Output     dto.ExtendChargesResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var ExtendChargesCases = []ExtendChargesCase{
+	{
+		Name:    "repository error",
+		WantErr: uc_errors.ErrExtendCharges,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:       "success extend",
+		RepoOutput: 12,
+		Output:     dto.ExtendChargesResponse{Added: 12},
+	},
+}
+
+func TestExtendChargesUC(t *testing.T) {
+	for _, tt := range ExtendChargesCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.ChargeRepository)
+			uc := &ExtendChargesUC{Charges: repo}
+
+			repo.On("Extend", mock.Anything, ledgerHorizon()).
+				Return(tt.RepoOutput, tt.RepoErr)
+
+			resp, err := uc.Execute(context.Background())
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetApprovalsUC struct {
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *GetApprovalsUC) Execute(ctx context.Context, in dto.GetApprovals) (dto.GetApprovalsResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.GetApprovalsResponse{}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if _, err := uc.Subscriptions.Get(ctx, in.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetApprovalsResponse{}, uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.GetApprovalsResponse{}, uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	decisions, err := uc.Subscriptions.GetApprovals(ctx, in.ID)
+	if err != nil {
+		return dto.GetApprovalsResponse{}, uc_errors.Wrap(uc_errors.ErrGetApprovals, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetApprovalsDTO(decisions), nil
+}
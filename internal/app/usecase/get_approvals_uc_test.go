@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetApprovalsCase struct {
+	Name          string
+	Input         dto.GetApprovals
+	GetRepoOutput *entity.Subscription
+	RepoOutput    []entity.ApprovalDecision
+	Output        dto.GetApprovalsResponse
+	WantErr       error
+	GetRepoErr    error
+	RepoErr       error
+}
+
+var GetApprovalsCases = []GetApprovalsCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.GetApprovals{ID: 0},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.GetApprovals{ID: 1},
+		WantErr:    uc_errors.ErrSubscriptionNotFound,
+		GetRepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:          "repository error",
+		Input:         dto.GetApprovals{ID: 1},
+		GetRepoOutput: &entity.Subscription{ID: 1},
+		WantErr:       uc_errors.ErrGetApprovals,
+		RepoErr:       errors.New("db error"),
+	},
+
+	{
+		Name:          "success none",
+		Input:         dto.GetApprovals{ID: 1},
+		GetRepoOutput: &entity.Subscription{ID: 1, Approval: entity.ApprovalPending},
+		Output:        dto.GetApprovalsResponse{Items: []dto.ApprovalDecision{}},
+	},
+
+	{
+		Name:          "success",
+		Input:         dto.GetApprovals{ID: 1},
+		GetRepoOutput: &entity.Subscription{ID: 1, Approval: entity.ApprovalApproved},
+		RepoOutput: []entity.ApprovalDecision{
+			{
+				ID:             4,
+				SubscriptionID: 1,
+				Decision:       entity.ApprovalApproved,
+				DecidedBy:      approverID,
+				Comment:        vPtr("Budgeted for Q2"),
+				DecidedAt:      time.Date(2025, time.April, 2, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		Output: dto.GetApprovalsResponse{
+			Items: []dto.ApprovalDecision{
+				{
+					Decision:  "approved",
+					DecidedBy: approverID.String(),
+					Comment:   vPtr("Budgeted for Q2"),
+					DecidedAt: "2025-04-02T09:30:00Z",
+				},
+			},
+		},
+	},
+}
+
+func TestGetApprovalsUC(t *testing.T) {
+	for _, tt := range GetApprovalsCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			uc := &GetApprovalsUC{Subscriptions: repo}
+
+			if tt.GetRepoOutput != nil || tt.GetRepoErr != nil {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.GetRepoOutput, tt.GetRepoErr)
+			}
+			if tt.GetRepoOutput != nil {
+				repo.On("GetApprovals", mock.Anything, tt.Input.ID).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
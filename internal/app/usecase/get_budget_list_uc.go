@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type GetBudgetListUC struct {
+	Budgets port.BudgetRepository
+}
+
+func (uc *GetBudgetListUC) Execute(ctx context.Context, in dto.GetBudgetList) (dto.GetBudgetListResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.Limit < 0 {
+		return dto.GetBudgetListResponse{}, uc_errors.ErrInvalidLimit
+	}
+	if in.Offset < 0 {
+		return dto.GetBudgetListResponse{}, uc_errors.ErrInvalidOffset
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	var uidPtr *uuid.UUID
+	if in.UserID != nil && *in.UserID != "" {
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			return dto.GetBudgetListResponse{}, uc_errors.ErrInvalidUserID
+		}
+		uidPtr = &uid
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	budgets, err := uc.Budgets.GetList(ctx, filter.BudgetFilter{
+		UserID: uidPtr,
+		Limit:  limit,
+		Offset: in.Offset,
+	})
+	if err != nil {
+		return dto.GetBudgetListResponse{}, uc_errors.Wrap(uc_errors.ErrGetBudgetList, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetBudgetListDTO(budgets), nil
+}
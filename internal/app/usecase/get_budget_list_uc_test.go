@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetBudgetListCase struct {
+	Name       string
+	Input      dto.GetBudgetList
+	WantFilter filter.BudgetFilter
+	RepoOutput []entity.Budget
+	Output     dto.GetBudgetListResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetBudgetListCases = []GetBudgetListCase{
+	{
+		Name:    "negative limit",
+		Input:   dto.GetBudgetList{Limit: -1},
+		WantErr: uc_errors.ErrInvalidLimit,
+	},
+
+	{
+		Name:    "negative offset",
+		Input:   dto.GetBudgetList{Offset: -1},
+		WantErr: uc_errors.ErrInvalidOffset,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.GetBudgetList{UserID: vPtr("not-a-uuid")},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.GetBudgetList{},
+		WantFilter: filter.BudgetFilter{Limit: 100},
+		WantErr:    uc_errors.ErrGetBudgetList,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success",
+		Input:      dto.GetBudgetList{UserID: vPtr(budgetUserID.String()), Limit: 10, Offset: 5},
+		WantFilter: filter.BudgetFilter{UserID: &budgetUserID, Limit: 10, Offset: 5},
+		RepoOutput: []entity.Budget{
+			{ID: 1, UserID: budgetUserID, MonthlyLimit: 1000, Currency: "RUB"},
+		},
+		Output: dto.GetBudgetListResponse{
+			Budgets: []dto.GetBudgetResponse{
+				{ID: 1, UserID: budgetUserID.String(), MonthlyLimit: 1000, Currency: "RUB"},
+			},
+		},
+	},
+}
+
+func TestGetBudgetListUC(t *testing.T) {
+	for _, tt := range GetBudgetListCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.BudgetRepository)
+			uc := &GetBudgetListUC{Budgets: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetBudgetList)
+
+			if shouldCallRepo {
+				repo.On("GetList", mock.Anything, tt.WantFilter).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type GetBudgetStatusUC struct {
+	Budgets       port.BudgetRepository
+	Subscriptions port.SubscriptionRepository
+	Rates         port.ExchangeRateRepository
+}
+
+// Execute sets every budget of the user against what the user is charged this
+// month on the services it covers.
+func (uc *GetBudgetStatusUC) Execute(ctx context.Context, in dto.GetBudgetStatus) (dto.GetBudgetStatusResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.UserID == "" {
+		return dto.GetBudgetStatusResponse{}, uc_errors.ErrEmptyUserID
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	uid, err := uuid.Parse(in.UserID)
+	if err != nil || uid == uuid.Nil {
+		return dto.GetBudgetStatusResponse{}, uc_errors.ErrInvalidUserID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	budgets, err := uc.Budgets.GetList(ctx, filter.BudgetFilter{UserID: &uid})
+	if err != nil {
+		return dto.GetBudgetStatusResponse{}, uc_errors.Wrap(uc_errors.ErrGetBudgetList, err)
+	}
+
+	month := currentMonth()
+	spends := make([]entity.Money, 0, len(budgets))
+
+	for _, b := range budgets {
+		spent, err := budgetSpend(ctx, uc.Subscriptions, uc.Rates, b, month)
+		if err != nil {
+			return dto.GetBudgetStatusResponse{}, err
+		}
+		spends = append(spends, spent)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	items := make([]dto.BudgetStatus, 0, len(budgets))
+
+	for i, b := range budgets {
+		spent := spends[i]
+		items = append(items, dto.BudgetStatus{
+			Budget: mappers.MapIntoGetBudgetDTO(&b),
+			Spent:  dto.Money{Amount: spent.Amount, Currency: string(spent.Currency)},
+			Remaining: dto.Money{
+				Amount:   max(b.MonthlyLimit-spent.Amount, 0),
+				Currency: string(b.Currency),
+			},
+			Exceeded: spent.Amount > b.MonthlyLimit,
+		})
+	}
+
+	return dto.GetBudgetStatusResponse{
+		Month: month.Format("01-2006"),
+		Items: items,
+	}, nil
+}
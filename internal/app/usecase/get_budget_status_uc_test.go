@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetBudgetStatusCase struct {
+	Name       string
+	Input      dto.GetBudgetStatus
+	Budgets    []entity.Budget
+	BudgetsErr error
+	// Spend is this month's spend per budget id.
+	Spend   map[int][]entity.MonthlyAmount
+	SumsErr error
+	Rates   []rateCall
+	Output  dto.GetBudgetStatusResponse
+	WantErr error
+}
+
+var GetBudgetStatusCases = []GetBudgetStatusCase{
+	{
+		Name:    "empty user id",
+		Input:   dto.GetBudgetStatus{},
+		WantErr: uc_errors.ErrEmptyUserID,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.GetBudgetStatus{UserID: "not-a-uuid"},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:       "budgets error",
+		Input:      dto.GetBudgetStatus{UserID: budgetUserID.String()},
+		BudgetsErr: errors.New("db error"),
+		WantErr:    uc_errors.ErrGetBudgetList,
+	},
+
+	{
+		Name:  "sums error",
+		Input: dto.GetBudgetStatus{UserID: budgetUserID.String()},
+		Budgets: []entity.Budget{
+			{ID: 1, UserID: budgetUserID, MonthlyLimit: 1000, Currency: "RUB"},
+		},
+		Spend:   map[int][]entity.MonthlyAmount{1: nil},
+		SumsErr: errors.New("db error"),
+		WantErr: uc_errors.ErrCheckBudgets,
+	},
+
+	{
+		Name:  "success",
+		Input: dto.GetBudgetStatus{UserID: budgetUserID.String()},
+		Budgets: []entity.Budget{
+			{ID: 1, UserID: budgetUserID, MonthlyLimit: 1000, Currency: "RUB"},
+			{ID: 2, UserID: budgetUserID, ServiceName: vPtr("Netflix"), MonthlyLimit: 500, Currency: "RUB"},
+		},
+		Spend: map[int][]entity.MonthlyAmount{
+			1: {
+				{Month: currentMonth(), Money: entity.Money{Amount: 300, Currency: "RUB"}},
+				{Month: currentMonth(), Money: entity.Money{Amount: 5, Currency: "USD"}},
+			},
+			2: {
+				{Month: currentMonth(), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			},
+		},
+		Rates: []rateCall{
+			{From: "USD", On: currentMonth().AddDate(0, 1, -1).Format("02-01-2006"), Rate: 100},
+		},
+		Output: dto.GetBudgetStatusResponse{
+			Month: currentMonth().Format("01-2006"),
+			Items: []dto.BudgetStatus{
+				{
+					Budget:    dto.GetBudgetResponse{ID: 1, UserID: budgetUserID.String(), MonthlyLimit: 1000, Currency: "RUB"},
+					Spent:     dto.Money{Amount: 800, Currency: "RUB"},
+					Remaining: dto.Money{Amount: 200, Currency: "RUB"},
+				},
+				{
+					Budget: dto.GetBudgetResponse{
+						ID:           2,
+						UserID:       budgetUserID.String(),
+						ServiceName:  vPtr("Netflix"),
+						MonthlyLimit: 500,
+						Currency:     "RUB",
+					},
+					Spent:     dto.Money{Amount: 800, Currency: "RUB"},
+					Remaining: dto.Money{Amount: 0, Currency: "RUB"},
+					Exceeded:  true,
+				},
+			},
+		},
+	},
+}
+
+func TestGetBudgetStatusUC(t *testing.T) {
+	for _, tt := range GetBudgetStatusCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			budgets := new(mocks.BudgetRepository)
+			subs := new(mocks.SubscriptionRepository)
+			rates := new(mocks.ExchangeRateRepository)
+			uc := &GetBudgetStatusUC{Budgets: budgets, Subscriptions: subs, Rates: rates}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetBudgetList) ||
+					errors.Is(tt.WantErr, uc_errors.ErrCheckBudgets)
+
+			if shouldCallRepo {
+				budgets.On("GetList", mock.Anything, filter.BudgetFilter{UserID: &budgetUserID}).
+					Return(tt.Budgets, tt.BudgetsErr)
+			}
+
+			for _, b := range tt.Budgets {
+				sums, ok := tt.Spend[b.ID]
+				if !ok {
+					continue
+				}
+				subs.On("GetMonthlySums", mock.Anything, mock.MatchedBy(func(f filter.SumFilter) bool {
+					return f.StartDate.Equal(currentMonth()) && assert.ObjectsAreEqual(b.ServiceName, f.ServiceName)
+				})).Return(sums, tt.SumsErr).Once()
+			}
+
+			for _, rc := range tt.Rates {
+				rates.On("GetRate", mock.Anything, rc.From, entity.Currency("RUB"), parseTime(rc.On)).
+					Return(rc.Rate, rc.Err)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			budgets.AssertExpectations(t)
+			subs.AssertExpectations(t)
+			rates.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetBudgetUC struct {
+	Budgets port.BudgetRepository
+}
+
+func (uc *GetBudgetUC) Execute(ctx context.Context, in dto.GetBudget) (dto.GetBudgetResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.GetBudgetResponse{}, uc_errors.ErrInvalidBudgetID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	b, err := uc.Budgets.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetBudgetResponse{}, uc_errors.Wrap(uc_errors.ErrBudgetNotFound, err)
+		}
+		return dto.GetBudgetResponse{}, uc_errors.Wrap(uc_errors.ErrGetBudget, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetBudgetDTO(b), nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetBudgetCase struct {
+	Name       string
+	Input      dto.GetBudget
+	RepoOutput *entity.Budget
+	Output     dto.GetBudgetResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetBudgetCases = []GetBudgetCase{
+	{
+		Name:    "invalid budget id",
+		Input:   dto.GetBudget{ID: 0},
+		WantErr: uc_errors.ErrInvalidBudgetID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.GetBudget{ID: 1},
+		WantErr: uc_errors.ErrBudgetNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.GetBudget{ID: 1},
+		WantErr: uc_errors.ErrGetBudget,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "success",
+		Input: dto.GetBudget{ID: 1},
+		RepoOutput: &entity.Budget{
+			ID:           1,
+			UserID:       budgetUserID,
+			ServiceName:  vPtr("Netflix"),
+			MonthlyLimit: 1000,
+			Currency:     "RUB",
+		},
+		Output: dto.GetBudgetResponse{
+			ID:           1,
+			UserID:       budgetUserID.String(),
+			ServiceName:  vPtr("Netflix"),
+			MonthlyLimit: 1000,
+			Currency:     "RUB",
+		},
+	},
+}
+
+func TestGetBudgetUC(t *testing.T) {
+	for _, tt := range GetBudgetCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.BudgetRepository)
+			uc := &GetBudgetUC{Budgets: repo}
+
+			if tt.Input.ID > 0 {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
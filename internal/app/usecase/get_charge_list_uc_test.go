@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetChargeListCase struct {
+	Name       string
+	Input      dto.GetChargeList
+	RepoInput  *filter.ChargeFilter
+	RepoOutput []entity.Charge
+	Output     dto.GetChargeListResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetChargeListCases = []GetChargeListCase{
+	{
+		Name:    "negative limit",
+		Input:   dto.GetChargeList{Limit: -1},
+		WantErr: uc_errors.ErrInvalidLimit,
+	},
+
+	{
+		Name:    "negative offset",
+		Input:   dto.GetChargeList{Offset: -1},
+		WantErr: uc_errors.ErrInvalidOffset,
+	},
+
+	{
+		Name:    "invalid subscription id",
+		Input:   dto.GetChargeList{SubscriptionID: vPtr(0)},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.GetChargeList{UserID: vPtr(uuid.Nil.String())},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "invalid start date",
+		Input:   dto.GetChargeList{StartDate: vPtr("2025-01-01")},
+		WantErr: uc_errors.ErrInvalidDate,
+	},
+
+	{
+		Name: "end before start",
+		Input: dto.GetChargeList{
+			StartDate: vPtr("01-02-2025"),
+			EndDate:   vPtr("01-01-2025"),
+		},
+		WantErr: uc_errors.ErrInvalidPeriod,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.GetChargeList{},
+		WantErr: uc_errors.ErrGetCharges,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name: "success get charges",
+		Input: dto.GetChargeList{
+			SubscriptionID: vPtr(3),
+			StartDate:      vPtr("01-01-2025"),
+			EndDate:        vPtr("31-03-2025"),
+		},
+		RepoInput: &filter.ChargeFilter{
+			SubscriptionID: vPtr(3),
+			StartDate:      vPtr(parseTime("01-01-2025")),
+			EndDate:        vPtr(parseTime("31-03-2025")),
+			Limit:          100,
+		},
+		RepoOutput: []entity.Charge{
+			{ID: 10, SubscriptionID: 3, Date: parseTime("15-01-2025"), Money: entity.Money{Amount: 500, Currency: "RUB"}},
+			{ID: 11, SubscriptionID: 3, Date: parseTime("15-02-2025"), Money: entity.Money{Amount: 500, Currency: "RUB"}},
+		},
+		Output: dto.GetChargeListResponse{
+			Items: []dto.Charge{
+				{ID: 10, SubscriptionID: 3, Date: "15-01-2025", Amount: dto.Money{Amount: 500, Currency: "RUB"}},
+				{ID: 11, SubscriptionID: 3, Date: "15-02-2025", Amount: dto.Money{Amount: 500, Currency: "RUB"}},
+			},
+		},
+	},
+}
+
+func TestGetChargeListUC(t *testing.T) {
+	for _, tt := range GetChargeListCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.ChargeRepository)
+			uc := &GetChargeListUC{Charges: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetCharges)
+
+			if shouldCallRepo {
+				var f any = mock.Anything
+				if tt.RepoInput != nil {
+					f = *tt.RepoInput
+				}
+				repo.On("GetList", mock.Anything, f).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// GetChargebackUC reports what every cost centre is charged in a month, the
+// current one by default, counting the spend the same way as GetTotalSumUC.
+type GetChargebackUC struct {
+	Subscriptions port.SubscriptionRepository
+	Rates         port.ExchangeRateRepository
+}
+
+func (uc *GetChargebackUC) Execute(ctx context.Context, in dto.GetChargeback) (dto.GetChargebackResponse, error) {
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	month := currentMonth()
+	if in.Month != nil && *in.Month != "" {
+		m, err := time.Parse("01-2006", *in.Month)
+		if err != nil {
+			return dto.GetChargebackResponse{}, uc_errors.ErrInvalidMonth
+		}
+		month = m
+	}
+
+	var targetPtr *entity.Currency
+	if in.Currency != nil && *in.Currency != "" {
+		c, ok := entity.ParseCurrency(*in.Currency)
+		if !ok {
+			return dto.GetChargebackResponse{}, uc_errors.ErrInvalidCurrency
+		}
+		targetPtr = &c
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	end := month.AddDate(0, 1, -1)
+
+	rows, err := uc.Subscriptions.GetChargeback(ctx, filter.SumFilter{
+		StartDate: &month,
+		EndDate:   &end,
+	})
+	if err != nil {
+		return dto.GetChargebackResponse{}, uc_errors.Wrap(uc_errors.ErrGetChargeback, err)
+	}
+
+	/* ####################
+	   #	 Grouping     #
+	   ####################
+	*/
+	type line struct {
+		row       entity.ChargebackAmount
+		totals    []entity.Money
+		converted float64
+	}
+
+	var order []*line
+	index := make(map[int]*line)
+
+	// rows come ordered by cost centre, the unallocated ones keyed by 0
+	for _, r := range rows {
+		key := 0
+		if r.CostCentreID != nil {
+			key = *r.CostCentreID
+		}
+
+		l, ok := index[key]
+		if !ok {
+			l = &line{row: r}
+			index[key] = l
+			order = append(order, l)
+		}
+		l.totals = append(l.totals, r.Money)
+
+		if targetPtr != nil {
+			rate, err := monthlyRate(ctx, uc.Rates, r.Currency, *targetPtr, month)
+			if err != nil {
+				return dto.GetChargebackResponse{}, err
+			}
+			l.converted += float64(r.Amount) * rate
+		}
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	items := make([]dto.ChargebackLine, 0, len(order))
+	for _, l := range order {
+		item := dto.ChargebackLine{
+			CostCentreID:   l.row.CostCentreID,
+			CostCentreName: l.row.CostCentreName,
+			Totals:         mappers.MapIntoMoneyDTOs(l.totals),
+		}
+		if targetPtr != nil {
+			item.Total = &dto.Money{Amount: int(math.Round(l.converted)), Currency: string(*targetPtr)}
+		}
+		items = append(items, item)
+	}
+
+	return dto.GetChargebackResponse{
+		Month: month.Format("01-2006"),
+		Items: items,
+	}, nil
+}
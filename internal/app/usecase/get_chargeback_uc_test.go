@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetChargebackCase struct {
+	Name       string
+	Input      dto.GetChargeback
+	WantFilter *filter.SumFilter
+	RepoOutput []entity.ChargebackAmount
+	Rates      map[entity.Currency]float64
+	Output     dto.GetChargebackResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetChargebackCases = []GetChargebackCase{
+	{
+		Name:    "invalid month",
+		Input:   dto.GetChargeback{Month: vPtr("2025-03")},
+		WantErr: uc_errors.ErrInvalidMonth,
+	},
+
+	{
+		Name:    "invalid currency",
+		Input:   dto.GetChargeback{Month: vPtr("03-2025"), Currency: vPtr("usd1")},
+		WantErr: uc_errors.ErrInvalidCurrency,
+	},
+
+	{
+		Name:  "repository error",
+		Input: dto.GetChargeback{Month: vPtr("03-2025")},
+		WantFilter: &filter.SumFilter{
+			StartDate: vPtr(parseTime("01-03-2025")),
+			EndDate:   vPtr(parseTime("31-03-2025")),
+		},
+		WantErr: uc_errors.ErrGetChargeback,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "success per cost centre",
+		Input: dto.GetChargeback{Month: vPtr("02-2025")},
+		WantFilter: &filter.SumFilter{
+			StartDate: vPtr(parseTime("01-02-2025")),
+			EndDate:   vPtr(parseTime("28-02-2025")),
+		},
+		RepoOutput: []entity.ChargebackAmount{
+			{CostCentreID: vPtr(2), CostCentreName: vPtr("Engineering"), Money: entity.Money{Amount: 700, Currency: "EUR"}},
+			{CostCentreID: vPtr(2), CostCentreName: vPtr("Engineering"), Money: entity.Money{Amount: 300, Currency: "RUB"}},
+			{CostCentreID: vPtr(1), CostCentreName: vPtr("Marketing"), Money: entity.Money{Amount: 400, Currency: "RUB"}},
+			{Money: entity.Money{Amount: 100, Currency: "RUB"}},
+		},
+		Output: dto.GetChargebackResponse{
+			Month: "02-2025",
+			Items: []dto.ChargebackLine{
+				{
+					CostCentreID:   vPtr(2),
+					CostCentreName: vPtr("Engineering"),
+					Totals:         []dto.Money{{Amount: 700, Currency: "EUR"}, {Amount: 300, Currency: "RUB"}},
+				},
+				{
+					CostCentreID:   vPtr(1),
+					CostCentreName: vPtr("Marketing"),
+					Totals:         []dto.Money{{Amount: 400, Currency: "RUB"}},
+				},
+				{
+					Totals: []dto.Money{{Amount: 100, Currency: "RUB"}},
+				},
+			},
+		},
+	},
+
+	{
+		Name:  "success converted",
+		Input: dto.GetChargeback{Month: vPtr("02-2025"), Currency: vPtr("RUB")},
+		WantFilter: &filter.SumFilter{
+			StartDate: vPtr(parseTime("01-02-2025")),
+			EndDate:   vPtr(parseTime("28-02-2025")),
+		},
+		RepoOutput: []entity.ChargebackAmount{
+			{CostCentreID: vPtr(2), CostCentreName: vPtr("Engineering"), Money: entity.Money{Amount: 7, Currency: "EUR"}},
+			{CostCentreID: vPtr(2), CostCentreName: vPtr("Engineering"), Money: entity.Money{Amount: 300, Currency: "RUB"}},
+		},
+		Rates: map[entity.Currency]float64{"EUR": 100.5},
+		Output: dto.GetChargebackResponse{
+			Month: "02-2025",
+			Items: []dto.ChargebackLine{
+				{
+					CostCentreID:   vPtr(2),
+					CostCentreName: vPtr("Engineering"),
+					Totals:         []dto.Money{{Amount: 7, Currency: "EUR"}, {Amount: 300, Currency: "RUB"}},
+					Total:          &dto.Money{Amount: 1004, Currency: "RUB"},
+				},
+			},
+		},
+	},
+}
+
+func TestGetChargebackUC(t *testing.T) {
+	for _, tt := range GetChargebackCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			subs := new(mocks.SubscriptionRepository)
+			rates := new(mocks.ExchangeRateRepository)
+			uc := &GetChargebackUC{Subscriptions: subs, Rates: rates}
+
+			if tt.WantFilter != nil {
+				subs.On("GetChargeback", mock.Anything, *tt.WantFilter).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			// every row is converted at the rate known by the end of the month
+			for from, rate := range tt.Rates {
+				rates.On("GetRate", mock.Anything, from, entity.Currency(*tt.Input.Currency), *tt.WantFilter.EndDate).
+					Return(rate, nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			subs.AssertExpectations(t)
+			rates.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetCostCentreListUC struct {
+	CostCentres port.CostCentreRepository
+}
+
+func (uc *GetCostCentreListUC) Execute(ctx context.Context, in dto.GetCostCentreList) (dto.GetCostCentreListResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.Limit < 0 {
+		return dto.GetCostCentreListResponse{}, uc_errors.ErrInvalidLimit
+	}
+	if in.Offset < 0 {
+		return dto.GetCostCentreListResponse{}, uc_errors.ErrInvalidOffset
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	centres, err := uc.CostCentres.GetList(ctx, filter.CostCentreFilter{
+		Limit:  limit,
+		Offset: in.Offset,
+	})
+	if err != nil {
+		return dto.GetCostCentreListResponse{}, uc_errors.Wrap(uc_errors.ErrGetCostCentreList, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetCostCentreListDTO(centres), nil
+}
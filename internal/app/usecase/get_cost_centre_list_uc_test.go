@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetCostCentreListCase struct {
+	Name       string
+	Input      dto.GetCostCentreList
+	WantFilter filter.CostCentreFilter
+	RepoOutput []entity.CostCentre
+	Output     dto.GetCostCentreListResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetCostCentreListCases = []GetCostCentreListCase{
+	{
+		Name:    "negative limit",
+		Input:   dto.GetCostCentreList{Limit: -1},
+		WantErr: uc_errors.ErrInvalidLimit,
+	},
+
+	{
+		Name:    "negative offset",
+		Input:   dto.GetCostCentreList{Offset: -1},
+		WantErr: uc_errors.ErrInvalidOffset,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.GetCostCentreList{},
+		WantFilter: filter.CostCentreFilter{Limit: 100},
+		WantErr:    uc_errors.ErrGetCostCentreList,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success",
+		Input:      dto.GetCostCentreList{Limit: 10, Offset: 5},
+		WantFilter: filter.CostCentreFilter{Limit: 10, Offset: 5},
+		RepoOutput: []entity.CostCentre{
+			{ID: 1, Name: "Engineering", Code: vPtr("CC-100")},
+			{ID: 2, Name: "Marketing"},
+		},
+		Output: dto.GetCostCentreListResponse{
+			CostCentres: []dto.GetCostCentreResponse{
+				{ID: 1, Name: "Engineering", Code: vPtr("CC-100")},
+				{ID: 2, Name: "Marketing"},
+			},
+		},
+	},
+}
+
+func TestGetCostCentreListUC(t *testing.T) {
+	for _, tt := range GetCostCentreListCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.CostCentreRepository)
+			uc := &GetCostCentreListUC{CostCentres: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetCostCentreList)
+
+			if shouldCallRepo {
+				repo.On("GetList", mock.Anything, tt.WantFilter).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
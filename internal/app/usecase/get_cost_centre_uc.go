@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetCostCentreUC struct {
+	CostCentres port.CostCentreRepository
+}
+
+func (uc *GetCostCentreUC) Execute(ctx context.Context, in dto.GetCostCentre) (dto.GetCostCentreResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.GetCostCentreResponse{}, uc_errors.ErrInvalidCostCentreID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	c, err := uc.CostCentres.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetCostCentreResponse{}, uc_errors.Wrap(uc_errors.ErrCostCentreNotFound, err)
+		}
+		return dto.GetCostCentreResponse{}, uc_errors.Wrap(uc_errors.ErrGetCostCentre, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetCostCentreDTO(c), nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetCostCentreCase struct {
+	Name       string
+	Input      dto.GetCostCentre
+	RepoOutput *entity.CostCentre
+	Output     dto.GetCostCentreResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetCostCentreCases = []GetCostCentreCase{
+	{
+		Name:    "invalid cost centre id",
+		Input:   dto.GetCostCentre{ID: -1},
+		WantErr: uc_errors.ErrInvalidCostCentreID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.GetCostCentre{ID: 1},
+		WantErr: uc_errors.ErrCostCentreNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.GetCostCentre{ID: 1},
+		WantErr: uc_errors.ErrGetCostCentre,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:       "success",
+		Input:      dto.GetCostCentre{ID: 1},
+		RepoOutput: &entity.CostCentre{ID: 1, Name: "Marketing", Code: vPtr("CC-200")},
+		Output:     dto.GetCostCentreResponse{ID: 1, Name: "Marketing", Code: vPtr("CC-200")},
+	},
+}
+
+func TestGetCostCentreUC(t *testing.T) {
+	for _, tt := range GetCostCentreCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.CostCentreRepository)
+			uc := &GetCostCentreUC{CostCentres: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetCostCentre) ||
+					errors.Is(tt.WantErr, uc_errors.ErrCostCentreNotFound)
+
+			if shouldCallRepo {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+// GetDuplicatesUC reports subscriptions that already overlap another one of
+// the same user and service, which inflates every total they count towards.
+type GetDuplicatesUC struct {
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *GetDuplicatesUC) Execute(ctx context.Context, in dto.GetDuplicates) (dto.GetDuplicatesResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.Limit < 0 {
+		return dto.GetDuplicatesResponse{}, uc_errors.ErrInvalidLimit
+	}
+	if in.Offset < 0 {
+		return dto.GetDuplicatesResponse{}, uc_errors.ErrInvalidOffset
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	var uidPtr *uuid.UUID
+	if in.UserID != nil && *in.UserID != "" {
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			return dto.GetDuplicatesResponse{}, uc_errors.ErrInvalidUserID
+		}
+		uidPtr = &uid
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	overlaps, err := uc.Subscriptions.GetOverlaps(ctx, filter.DuplicateFilter{
+		UserID: uidPtr,
+		Limit:  limit,
+		Offset: in.Offset,
+	})
+	if err != nil {
+		return dto.GetDuplicatesResponse{}, uc_errors.Wrap(uc_errors.ErrGetDuplicates, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetDuplicatesDTO(overlaps), nil
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetDuplicatesCase struct {
+	Name       string
+	Input      dto.GetDuplicates
+	WantFilter filter.DuplicateFilter
+	RepoOutput []entity.Overlap
+	Output     dto.GetDuplicatesResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetDuplicatesCases = []GetDuplicatesCase{
+	{
+		Name:    "negative limit",
+		Input:   dto.GetDuplicates{Limit: -1},
+		WantErr: uc_errors.ErrInvalidLimit,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.GetDuplicates{UserID: vPtr("not-a-uuid")},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "negative offset",
+		Input:   dto.GetDuplicates{Offset: -1},
+		WantErr: uc_errors.ErrInvalidOffset,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.GetDuplicates{},
+		WantFilter: filter.DuplicateFilter{Limit: 100},
+		WantErr:    uc_errors.ErrGetDuplicates,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success",
+		Input:      dto.GetDuplicates{UserID: vPtr(budgetUserID.String()), Limit: 10, Offset: 5},
+		WantFilter: filter.DuplicateFilter{UserID: &budgetUserID, Limit: 10, Offset: 5},
+		RepoOutput: []entity.Overlap{
+			{UserID: budgetUserID, ServiceName: "Spotify", FirstID: 1, SecondID: 4, From: parseTime("01-03-2025")},
+			{
+				UserID:      budgetUserID,
+				ServiceName: "Spotify",
+				FirstID:     1,
+				SecondID:    9,
+				From:        parseTime("01-05-2025"),
+				To:          vPtr(parseTime("31-05-2025")),
+			},
+		},
+		Output: dto.GetDuplicatesResponse{
+			Items: []dto.Duplicate{
+				{
+					UserID:          budgetUserID.String(),
+					ServiceName:     "Spotify",
+					SubscriptionIDs: []int{1, 4},
+					OverlapFrom:     "01-03-2025",
+				},
+				{
+					UserID:          budgetUserID.String(),
+					ServiceName:     "Spotify",
+					SubscriptionIDs: []int{1, 9},
+					OverlapFrom:     "01-05-2025",
+					OverlapTo:       vPtr("31-05-2025"),
+				},
+			},
+		},
+	},
+}
+
+func TestGetDuplicatesUC(t *testing.T) {
+	for _, tt := range GetDuplicatesCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			uc := &GetDuplicatesUC{Subscriptions: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetDuplicates)
+
+			if shouldCallRepo {
+				repo.On("GetOverlaps", mock.Anything, tt.WantFilter).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
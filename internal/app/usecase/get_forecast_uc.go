@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"math"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultForecastMonths = 12
+	maxForecastMonths     = 60
+)
+
+type GetForecastUC struct {
+	Subscriptions port.SubscriptionRepository
+	Rates         port.ExchangeRateRepository
+}
+
+// Execute projects the monthly spend of the months following the current one
+// from the subscriptions as they stand: billing periods, scheduled price and
+// trial changes, end dates and open pauses are all taken into account. Future
+// months are converted at the latest known rate.
+func (uc *GetForecastUC) Execute(ctx context.Context, in dto.GetForecast) (dto.GetForecastResponse, error) {
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	var uidPtr *uuid.UUID
+	if in.UserID != nil && *in.UserID != "" {
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			return dto.GetForecastResponse{}, uc_errors.ErrInvalidUserID
+		}
+		uidPtr = &uid
+	}
+
+	months := defaultForecastMonths
+	if in.Months != nil {
+		months = *in.Months
+	}
+	if months < 1 || months > maxForecastMonths {
+		return dto.GetForecastResponse{}, uc_errors.ErrInvalidMonths
+	}
+
+	var targetPtr *entity.Currency
+	if in.Currency != nil && *in.Currency != "" {
+		c, ok := entity.ParseCurrency(*in.Currency)
+		if !ok {
+			return dto.GetForecastResponse{}, uc_errors.ErrInvalidCurrency
+		}
+		targetPtr = &c
+	}
+
+	now := today()
+	from := now.AddDate(0, 1, 1-now.Day())
+	to := from.AddDate(0, months, -1)
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	sums, err := uc.Subscriptions.GetForecast(ctx, filter.SumFilter{
+		UserID:    uidPtr,
+		StartDate: &from,
+		EndDate:   &to,
+	})
+	if err != nil {
+		return dto.GetForecastResponse{}, uc_errors.Wrap(uc_errors.ErrGetForecast, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	points := make([]dto.ForecastMonth, 0, months)
+	var committed, assumed []float64
+
+	for _, s := range sums {
+		month := s.Month.Format("01-2006")
+		if len(points) == 0 || points[len(points)-1].Month != month {
+			points = append(points, dto.ForecastMonth{
+				Month:     month,
+				Committed: []dto.Money{},
+				Assumed:   []dto.Money{},
+			})
+			committed = append(committed, 0)
+			assumed = append(assumed, 0)
+		}
+
+		// an empty currency marks a month without charges
+		if s.Currency == "" {
+			continue
+		}
+
+		i := len(points) - 1
+		m := dto.Money{Amount: s.Amount, Currency: string(s.Currency)}
+		if s.Committed {
+			points[i].Committed = append(points[i].Committed, m)
+		} else {
+			points[i].Assumed = append(points[i].Assumed, m)
+		}
+
+		if targetPtr != nil {
+			rate, err := monthlyRate(ctx, uc.Rates, s.Currency, *targetPtr, s.Month)
+			if err != nil {
+				return dto.GetForecastResponse{}, err
+			}
+
+			if s.Committed {
+				committed[i] += float64(s.Amount) * rate
+			} else {
+				assumed[i] += float64(s.Amount) * rate
+			}
+		}
+	}
+
+	if targetPtr != nil {
+		for i := range points {
+			points[i].CommittedTotal = &dto.Money{Amount: int(math.Round(committed[i])), Currency: string(*targetPtr)}
+			points[i].AssumedTotal = &dto.Money{Amount: int(math.Round(assumed[i])), Currency: string(*targetPtr)}
+		}
+	}
+
+	return dto.GetForecastResponse{
+		From:   from.Format("02-01-2006"),
+		To:     to.Format("02-01-2006"),
+		Months: points,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// the forecast starts on the first day of next month
+var (
+	forecastFrom  = today().AddDate(0, 1, 1-today().Day())
+	forecastNext  = forecastFrom.AddDate(0, 1, 0)
+	forecastMonth = forecastFrom.Format("01-2006")
+)
+
+type GetForecastCase struct {
+	Name       string
+	Input      dto.GetForecast
+	WantMonths int
+	RepoOutput []entity.ForecastAmount
+	Rates      []rateCall
+	Output     dto.GetForecastResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetForecastCases = []GetForecastCase{
+	{
+		Name:    "invalid user id",
+		Input:   dto.GetForecast{UserID: vPtr("not-a-uuid")},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "zero months",
+		Input:   dto.GetForecast{Months: vPtr(0)},
+		WantErr: uc_errors.ErrInvalidMonths,
+	},
+
+	{
+		Name:    "too many months",
+		Input:   dto.GetForecast{Months: vPtr(61)},
+		WantErr: uc_errors.ErrInvalidMonths,
+	},
+
+	{
+		Name:    "invalid currency",
+		Input:   dto.GetForecast{Currency: vPtr("XXX")},
+		WantErr: uc_errors.ErrInvalidCurrency,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.GetForecast{},
+		WantMonths: 12,
+		WantErr:    uc_errors.ErrGetForecast,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success split",
+		Input:      dto.GetForecast{Months: vPtr(2)},
+		WantMonths: 2,
+		RepoOutput: []entity.ForecastAmount{
+			{Month: forecastFrom, Committed: true, Money: entity.Money{Amount: 1000, Currency: "RUB"}},
+			{Month: forecastFrom, Committed: false, Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{Month: forecastFrom, Committed: false, Money: entity.Money{Amount: 10, Currency: "USD"}},
+			{Month: forecastNext, Money: entity.Money{Amount: 0, Currency: ""}},
+		},
+		Output: dto.GetForecastResponse{
+			From: forecastFrom.Format("02-01-2006"),
+			To:   forecastFrom.AddDate(0, 2, -1).Format("02-01-2006"),
+			Months: []dto.ForecastMonth{
+				{
+					Month:     forecastMonth,
+					Committed: []dto.Money{{Amount: 1000, Currency: "RUB"}},
+					Assumed:   []dto.Money{{Amount: 800, Currency: "RUB"}, {Amount: 10, Currency: "USD"}},
+				},
+				{
+					Month:     forecastNext.Format("01-2006"),
+					Committed: []dto.Money{},
+					Assumed:   []dto.Money{},
+				},
+			},
+		},
+	},
+
+	{
+		Name:       "success converted",
+		Input:      dto.GetForecast{Months: vPtr(1), Currency: vPtr("RUB")},
+		WantMonths: 1,
+		RepoOutput: []entity.ForecastAmount{
+			{Month: forecastFrom, Committed: true, Money: entity.Money{Amount: 1000, Currency: "RUB"}},
+			{Month: forecastFrom, Committed: false, Money: entity.Money{Amount: 10, Currency: "USD"}},
+		},
+		Rates: []rateCall{
+			{From: "USD", On: forecastNext.AddDate(0, 0, -1).Format("02-01-2006"), Rate: 100},
+		},
+		Output: dto.GetForecastResponse{
+			From: forecastFrom.Format("02-01-2006"),
+			To:   forecastNext.AddDate(0, 0, -1).Format("02-01-2006"),
+			Months: []dto.ForecastMonth{
+				{
+					Month:          forecastMonth,
+					Committed:      []dto.Money{{Amount: 1000, Currency: "RUB"}},
+					Assumed:        []dto.Money{{Amount: 10, Currency: "USD"}},
+					CommittedTotal: &dto.Money{Amount: 1000, Currency: "RUB"},
+					AssumedTotal:   &dto.Money{Amount: 1000, Currency: "RUB"},
+				},
+			},
+		},
+	},
+}
+
+func TestGetForecastUC(t *testing.T) {
+	for _, tt := range GetForecastCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			rates := new(mocks.ExchangeRateRepository)
+			uc := &GetForecastUC{Subscriptions: repo, Rates: rates}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetForecast)
+
+			if shouldCallRepo {
+				wantTo := forecastFrom.AddDate(0, tt.WantMonths, -1)
+				repo.On("GetForecast", mock.Anything, mock.MatchedBy(func(f filter.SumFilter) bool {
+					return f.StartDate.Equal(forecastFrom) && f.EndDate.Equal(wantTo)
+				})).Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			for _, rc := range tt.Rates {
+				rates.On("GetRate", mock.Anything, rc.From, entity.Currency(*tt.Input.Currency), parseTime(rc.On)).
+					Return(rc.Rate, rc.Err)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			rates.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type GetNoticeDeadlinesUC struct {
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *GetNoticeDeadlinesUC) Execute(ctx context.Context, in dto.GetNoticeDeadlines) (dto.GetNoticeDeadlinesResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.VendorID != nil && *in.VendorID <= 0 {
+		return dto.GetNoticeDeadlinesResponse{}, uc_errors.ErrInvalidVendorID
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	var ownerPtr *uuid.UUID
+	if in.RenewalOwnerID != nil && *in.RenewalOwnerID != "" {
+		owner, err := uuid.Parse(*in.RenewalOwnerID)
+		if err != nil || owner == uuid.Nil {
+			return dto.GetNoticeDeadlinesResponse{}, uc_errors.ErrInvalidRenewalOwnerID
+		}
+		ownerPtr = &owner
+	}
+
+	days := 30
+	if in.Within != nil && *in.Within != "" {
+		d, ok := parseWithin(*in.Within)
+		if !ok {
+			return dto.GetNoticeDeadlinesResponse{}, uc_errors.ErrInvalidWithin
+		}
+		days = d
+	}
+
+	from := today()
+	to := from.AddDate(0, 0, days)
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	contracts, err := uc.Subscriptions.GetContracts(ctx, filter.ContractFilter{
+		RenewalOwner: ownerPtr,
+		VendorID:     in.VendorID,
+	})
+	if err != nil {
+		return dto.GetNoticeDeadlinesResponse{}, uc_errors.Wrap(uc_errors.ErrGetNoticeDeadlines, err)
+	}
+
+	/* ####################
+	   #	 Selection    #
+	   ####################
+	*/
+	// a deadline already missed is followed by the one of the next term
+	var deadlines []entity.NoticeDeadline
+	for _, s := range contracts {
+		d, ok := s.NextNoticeDeadline(from)
+		if ok && !d.Deadline.After(to) {
+			deadlines = append(deadlines, d)
+		}
+	}
+
+	sort.SliceStable(deadlines, func(i, j int) bool {
+		return deadlines[i].Deadline.Before(deadlines[j].Deadline)
+	})
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoNoticeDeadlinesDTO(from, to, deadlines), nil
+}
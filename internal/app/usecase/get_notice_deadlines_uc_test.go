@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var renewalOwnerID = uuid.New()
+
+// annualStart starts a yearly contract that renews in about 40 days.
+var annualStart = today().AddDate(-1, 0, 40)
+
+type GetNoticeDeadlinesCase struct {
+	Name       string
+	Input      dto.GetNoticeDeadlines
+	WantDays   int
+	RepoOutput []entity.Subscription
+	Output     []dto.NoticeDeadline
+	WantErr    error
+	RepoErr    error
+}
+
+var GetNoticeDeadlinesCases = []GetNoticeDeadlinesCase{
+	{
+		Name:    "invalid vendor id",
+		Input:   dto.GetNoticeDeadlines{VendorID: vPtr(0)},
+		WantErr: uc_errors.ErrInvalidVendorID,
+	},
+
+	{
+		Name:    "invalid renewal owner id",
+		Input:   dto.GetNoticeDeadlines{RenewalOwnerID: vPtr("not-a-uuid")},
+		WantErr: uc_errors.ErrInvalidRenewalOwnerID,
+	},
+
+	{
+		Name:    "invalid within",
+		Input:   dto.GetNoticeDeadlines{Within: vPtr("1m")},
+		WantErr: uc_errors.ErrInvalidWithin,
+	},
+
+	{
+		Name:     "repository error",
+		Input:    dto.GetNoticeDeadlines{},
+		WantDays: 30,
+		WantErr:  uc_errors.ErrGetNoticeDeadlines,
+		RepoErr:  errors.New("db error"),
+	},
+
+	{
+		Name:     "success default window",
+		Input:    dto.GetNoticeDeadlines{RenewalOwnerID: vPtr(renewalOwnerID.String()), VendorID: vPtr(2)},
+		WantDays: 30,
+		Output:   []dto.NoticeDeadline{},
+	},
+
+	{
+		Name:     "success soonest deadline first",
+		Input:    dto.GetNoticeDeadlines{Within: vPtr("3w")},
+		WantDays: 21,
+		RepoOutput: []entity.Subscription{
+			{
+				ID:                2,
+				ServiceName:       "Jira",
+				BillingPeriod:     entity.BillingPeriodMonthly,
+				StartDate:         annualStart,
+				VendorID:          vPtr(2),
+				VendorName:        vPtr("Atlassian"),
+				MinimumTermMonths: vPtr(12),
+				NoticePeriodDays:  vPtr(30),
+				RenewalOwner:      &renewalOwnerID,
+			},
+			{
+				// the deadline two days ago has passed, the next term's counts
+				ID:               1,
+				ServiceName:      "Cleaning",
+				BillingPeriod:    entity.BillingPeriodWeekly,
+				StartDate:        today().AddDate(0, 0, -5),
+				NoticePeriodDays: vPtr(3),
+			},
+			{
+				// renews in a year
+				ID:                3,
+				ServiceName:       "Photoshop",
+				BillingPeriod:     entity.BillingPeriodMonthly,
+				StartDate:         today().AddDate(0, 0, -1),
+				MinimumTermMonths: vPtr(12),
+				NoticePeriodDays:  vPtr(30),
+			},
+		},
+		Output: []dto.NoticeDeadline{
+			{
+				SubscriptionID:   1,
+				ServiceName:      "Cleaning",
+				NoticePeriodDays: 3,
+				NoticeDeadline:   today().AddDate(0, 0, 6).Format("02-01-2006"),
+				DaysLeft:         6,
+				RenewsOn:         today().AddDate(0, 0, 9).Format("02-01-2006"),
+			},
+			{
+				SubscriptionID:    2,
+				ServiceName:       "Jira",
+				VendorID:          vPtr(2),
+				VendorName:        vPtr("Atlassian"),
+				RenewalOwnerID:    vPtr(renewalOwnerID.String()),
+				MinimumTermMonths: vPtr(12),
+				NoticePeriodDays:  30,
+				NoticeDeadline:    annualStart.AddDate(1, 0, -30).Format("02-01-2006"),
+				DaysLeft:          int(annualStart.AddDate(1, 0, -30).Sub(today()).Hours() / 24),
+				RenewsOn:          annualStart.AddDate(1, 0, 0).Format("02-01-2006"),
+			},
+		},
+	},
+}
+
+func TestGetNoticeDeadlinesUC(t *testing.T) {
+	for _, tt := range GetNoticeDeadlinesCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			uc := &GetNoticeDeadlinesUC{Subscriptions: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetNoticeDeadlines)
+
+			if shouldCallRepo {
+				repo.On("GetContracts", mock.Anything, mock.MatchedBy(func(f filter.ContractFilter) bool {
+					return (f.RenewalOwner == nil) == (tt.Input.RenewalOwnerID == nil) &&
+						(f.VendorID == nil) == (tt.Input.VendorID == nil)
+				})).Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				from := today()
+				assert.NoError(t, err)
+				assert.Equal(t, dto.GetNoticeDeadlinesResponse{
+					From:  from.Format("02-01-2006"),
+					To:    from.AddDate(0, 0, tt.WantDays).Format("02-01-2006"),
+					Items: tt.Output,
+				}, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type GetOneOffChargeListUC struct {
+	Charges port.OneOffChargeRepository
+}
+
+func (uc *GetOneOffChargeListUC) Execute(ctx context.Context, in dto.GetOneOffChargeList) (dto.GetOneOffChargeListResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.Limit < 0 {
+		return dto.GetOneOffChargeListResponse{}, uc_errors.ErrInvalidLimit
+	}
+	if in.Offset < 0 {
+		return dto.GetOneOffChargeListResponse{}, uc_errors.ErrInvalidOffset
+	}
+	if in.SubscriptionID != nil && *in.SubscriptionID <= 0 {
+		return dto.GetOneOffChargeListResponse{}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	var uidPtr *uuid.UUID
+	if in.UserID != nil && *in.UserID != "" {
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			return dto.GetOneOffChargeListResponse{}, uc_errors.ErrInvalidUserID
+		}
+		uidPtr = &uid
+	}
+
+	var startPtr *time.Time
+	if in.StartDate != nil && *in.StartDate != "" {
+		t, err := time.Parse("02-01-2006", *in.StartDate)
+		if err != nil {
+			return dto.GetOneOffChargeListResponse{}, uc_errors.ErrInvalidDate
+		}
+		startPtr = &t
+	}
+
+	var endPtr *time.Time
+	if in.EndDate != nil && *in.EndDate != "" {
+		t, err := time.Parse("02-01-2006", *in.EndDate)
+		if err != nil {
+			return dto.GetOneOffChargeListResponse{}, uc_errors.ErrInvalidDate
+		}
+		endPtr = &t
+	}
+
+	if startPtr != nil && endPtr != nil && endPtr.Before(*startPtr) {
+		return dto.GetOneOffChargeListResponse{}, uc_errors.ErrInvalidPeriod
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	charges, err := uc.Charges.GetList(ctx, filter.OneOffChargeFilter{
+		UserID:         uidPtr,
+		SubscriptionID: in.SubscriptionID,
+		StartDate:      startPtr,
+		EndDate:        endPtr,
+		Limit:          limit,
+		Offset:         in.Offset,
+	})
+	if err != nil {
+		return dto.GetOneOffChargeListResponse{}, uc_errors.Wrap(uc_errors.ErrGetOneOffChargeList, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetOneOffChargeListDTO(charges), nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetOneOffChargeListCase struct {
+	Name       string
+	Input      dto.GetOneOffChargeList
+	WantFilter filter.OneOffChargeFilter
+	RepoOutput []entity.OneOffCharge
+	Output     dto.GetOneOffChargeListResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetOneOffChargeListCases = []GetOneOffChargeListCase{
+	{
+		Name:    "negative limit",
+		Input:   dto.GetOneOffChargeList{Limit: -1},
+		WantErr: uc_errors.ErrInvalidLimit,
+	},
+
+	{
+		Name:    "invalid subscription id",
+		Input:   dto.GetOneOffChargeList{SubscriptionID: vPtr(0)},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.GetOneOffChargeList{UserID: vPtr("not-a-uuid")},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "end before start",
+		Input:   dto.GetOneOffChargeList{StartDate: vPtr("01-03-2025"), EndDate: vPtr("01-02-2025")},
+		WantErr: uc_errors.ErrInvalidPeriod,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.GetOneOffChargeList{},
+		WantFilter: filter.OneOffChargeFilter{Limit: 100},
+		WantErr:    uc_errors.ErrGetOneOffChargeList,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name: "success",
+		Input: dto.GetOneOffChargeList{
+			UserID:    vPtr(oneOffUser.String()),
+			StartDate: vPtr("01-01-2025"),
+			EndDate:   vPtr("31-03-2025"),
+		},
+		WantFilter: filter.OneOffChargeFilter{
+			UserID:    &oneOffUser,
+			StartDate: vPtr(parseTime("01-01-2025")),
+			EndDate:   vPtr(parseTime("31-03-2025")),
+			Limit:     100,
+		},
+		RepoOutput: []entity.OneOffCharge{
+			{
+				ID:          2,
+				UserID:      oneOffUser,
+				Date:        parseTime("15-03-2025"),
+				Description: "Conference ticket",
+				Money:       entity.Money{Amount: 500, Currency: "USD"},
+			},
+		},
+		Output: dto.GetOneOffChargeListResponse{
+			Charges: []dto.GetOneOffChargeResponse{
+				{
+					ID:          2,
+					UserID:      oneOffUser.String(),
+					Date:        "15-03-2025",
+					Amount:      dto.Money{Amount: 500, Currency: "USD"},
+					Description: "Conference ticket",
+				},
+			},
+		},
+	},
+}
+
+func TestGetOneOffChargeListUC(t *testing.T) {
+	for _, tt := range GetOneOffChargeListCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.OneOffChargeRepository)
+			uc := &GetOneOffChargeListUC{Charges: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetOneOffChargeList)
+
+			if shouldCallRepo {
+				repo.On("GetList", mock.Anything, tt.WantFilter).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetOneOffChargeUC struct {
+	Charges port.OneOffChargeRepository
+}
+
+func (uc *GetOneOffChargeUC) Execute(ctx context.Context, in dto.GetOneOffCharge) (dto.GetOneOffChargeResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.GetOneOffChargeResponse{}, uc_errors.ErrInvalidOneOffChargeID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	c, err := uc.Charges.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetOneOffChargeResponse{}, uc_errors.Wrap(uc_errors.ErrOneOffChargeNotFound, err)
+		}
+		return dto.GetOneOffChargeResponse{}, uc_errors.Wrap(uc_errors.ErrGetOneOffCharge, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetOneOffChargeDTO(c), nil
+}
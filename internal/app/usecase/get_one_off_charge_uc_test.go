@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetOneOffChargeCase struct {
+	Name       string
+	Input      dto.GetOneOffCharge
+	RepoOutput *entity.OneOffCharge
+	Output     dto.GetOneOffChargeResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetOneOffChargeCases = []GetOneOffChargeCase{
+	{
+		Name:    "invalid charge id",
+		Input:   dto.GetOneOffCharge{ID: -1},
+		WantErr: uc_errors.ErrInvalidOneOffChargeID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.GetOneOffCharge{ID: 1},
+		WantErr: uc_errors.ErrOneOffChargeNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.GetOneOffCharge{ID: 1},
+		WantErr: uc_errors.ErrGetOneOffCharge,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "success",
+		Input: dto.GetOneOffCharge{ID: 1},
+		RepoOutput: &entity.OneOffCharge{
+			ID:             1,
+			SubscriptionID: vPtr(7),
+			ServiceName:    vPtr("Jira"),
+			UserID:         oneOffOwner,
+			Date:           parseTime("01-02-2025"),
+			Description:    "Setup fee",
+			Money:          entity.Money{Amount: 2000, Currency: "EUR"},
+		},
+		Output: dto.GetOneOffChargeResponse{
+			ID:             1,
+			SubscriptionID: vPtr(7),
+			ServiceName:    vPtr("Jira"),
+			UserID:         oneOffOwner.String(),
+			Date:           "01-02-2025",
+			Amount:         dto.Money{Amount: 2000, Currency: "EUR"},
+			Description:    "Setup fee",
+		},
+	},
+}
+
+func TestGetOneOffChargeUC(t *testing.T) {
+	for _, tt := range GetOneOffChargeCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.OneOffChargeRepository)
+			uc := &GetOneOffChargeUC{Charges: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetOneOffCharge) ||
+					errors.Is(tt.WantErr, uc_errors.ErrOneOffChargeNotFound)
+
+			if shouldCallRepo {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
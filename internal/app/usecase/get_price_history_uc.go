@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetPriceHistoryUC struct {
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *GetPriceHistoryUC) Execute(ctx context.Context, in dto.GetPriceHistory) (dto.GetPriceHistoryResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.GetPriceHistoryResponse{}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	sub, err := uc.Subscriptions.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetPriceHistoryResponse{}, uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.GetPriceHistoryResponse{}, uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	periods, err := uc.Subscriptions.GetPriceHistory(ctx, in.ID)
+	if err != nil {
+		return dto.GetPriceHistoryResponse{}, uc_errors.Wrap(uc_errors.ErrGetPriceHistory, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoPriceHistoryDTO(sub, periods), nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetPriceHistoryCase struct {
+	Name          string
+	Input         dto.GetPriceHistory
+	GetRepoOutput *entity.Subscription
+	RepoOutput    []entity.PricePeriod
+	Output        dto.GetPriceHistoryResponse
+	WantErr       error
+	GetRepoErr    error
+	RepoErr       error
+}
+
+var GetPriceHistoryCases = []GetPriceHistoryCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.GetPriceHistory{ID: -1},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.GetPriceHistory{ID: 1},
+		WantErr:    uc_errors.ErrSubscriptionNotFound,
+		GetRepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:          "repository error",
+		Input:         dto.GetPriceHistory{ID: 1},
+		GetRepoOutput: &entity.Subscription{ID: 1, Currency: "RUB"},
+		WantErr:       uc_errors.ErrGetPriceHistory,
+		RepoErr:       errors.New("db error"),
+	},
+
+	{
+		Name:          "success get price history",
+		Input:         dto.GetPriceHistory{ID: 1},
+		GetRepoOutput: &entity.Subscription{ID: 1, Currency: "RUB"},
+		RepoOutput: []entity.PricePeriod{
+			{ID: 1, SubscriptionID: 1, Price: 300, EffectiveFrom: parseTime("15-01-2025")},
+			{ID: 2, SubscriptionID: 1, Price: 350, EffectiveFrom: parseTime("01-06-2025")},
+		},
+		Output: dto.GetPriceHistoryResponse{
+			Items: []dto.PricePeriod{
+				{Price: 300, Currency: "RUB", EffectiveFrom: "15-01-2025", EffectiveTo: vPtr("31-05-2025")},
+				{Price: 350, Currency: "RUB", EffectiveFrom: "01-06-2025", EffectiveTo: nil},
+			},
+		},
+	},
+}
+
+func TestGetPriceHistoryUC(t *testing.T) {
+	for _, tt := range GetPriceHistoryCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			uc := &GetPriceHistoryUC{Subscriptions: repo}
+
+			if tt.Input.ID > 0 {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.GetRepoOutput, tt.GetRepoErr)
+			}
+			if tt.GetRepoOutput != nil {
+				repo.On("GetPriceHistory", mock.Anything, tt.Input.ID).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
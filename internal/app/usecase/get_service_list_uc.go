@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetServiceListUC struct {
+	Services port.ServiceRepository
+}
+
+func (uc *GetServiceListUC) Execute(ctx context.Context, in dto.GetServiceList) (dto.GetServiceListResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.Limit < 0 {
+		return dto.GetServiceListResponse{}, uc_errors.ErrInvalidLimit
+	}
+	if in.Offset < 0 {
+		return dto.GetServiceListResponse{}, uc_errors.ErrInvalidOffset
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	services, err := uc.Services.GetList(ctx, filter.ServiceFilter{
+		Limit:  limit,
+		Offset: in.Offset,
+	})
+	if err != nil {
+		return dto.GetServiceListResponse{}, uc_errors.Wrap(uc_errors.ErrGetServiceList, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetServiceListDTO(services), nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetServiceListCase struct {
+	Name       string
+	Input      dto.GetServiceList
+	WantFilter filter.ServiceFilter
+	RepoOutput []entity.Service
+	Output     dto.GetServiceListResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetServiceListCases = []GetServiceListCase{
+	{
+		Name:    "negative limit",
+		Input:   dto.GetServiceList{Limit: -1},
+		WantErr: uc_errors.ErrInvalidLimit,
+	},
+
+	{
+		Name:    "negative offset",
+		Input:   dto.GetServiceList{Offset: -1},
+		WantErr: uc_errors.ErrInvalidOffset,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.GetServiceList{},
+		WantFilter: filter.ServiceFilter{Limit: 100},
+		WantErr:    uc_errors.ErrGetServiceList,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success",
+		Input:      dto.GetServiceList{Limit: 10, Offset: 5},
+		WantFilter: filter.ServiceFilter{Limit: 10, Offset: 5},
+		RepoOutput: []entity.Service{
+			{ID: 1, Name: "Netflix", Aliases: []string{"NFLX"}},
+		},
+		Output: dto.GetServiceListResponse{
+			Services: []dto.GetServiceResponse{
+				{ID: 1, Name: "Netflix", Aliases: []string{"NFLX"}},
+			},
+		},
+	},
+}
+
+func TestGetServiceListUC(t *testing.T) {
+	for _, tt := range GetServiceListCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.ServiceRepository)
+			uc := &GetServiceListUC{Services: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetServiceList)
+
+			if shouldCallRepo {
+				repo.On("GetList", mock.Anything, tt.WantFilter).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetServiceUC struct {
+	Services port.ServiceRepository
+}
+
+func (uc *GetServiceUC) Execute(ctx context.Context, in dto.GetService) (dto.GetServiceResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.GetServiceResponse{}, uc_errors.ErrInvalidServiceID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	s, err := uc.Services.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetServiceResponse{}, uc_errors.Wrap(uc_errors.ErrServiceNotFound, err)
+		}
+		return dto.GetServiceResponse{}, uc_errors.Wrap(uc_errors.ErrGetService, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetServiceDTO(s), nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetServiceCase struct {
+	Name       string
+	Input      dto.GetService
+	RepoOutput *entity.Service
+	Output     dto.GetServiceResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetServiceCases = []GetServiceCase{
+	{
+		Name:    "invalid service id",
+		Input:   dto.GetService{ID: -1},
+		WantErr: uc_errors.ErrInvalidServiceID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.GetService{ID: 1},
+		WantErr: uc_errors.ErrServiceNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.GetService{ID: 1},
+		WantErr: uc_errors.ErrGetService,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:       "success without aliases",
+		Input:      dto.GetService{ID: 1},
+		RepoOutput: &entity.Service{ID: 1, Name: "Netflix"},
+		Output:     dto.GetServiceResponse{ID: 1, Name: "Netflix", Aliases: []string{}},
+	},
+}
+
+func TestGetServiceUC(t *testing.T) {
+	for _, tt := range GetServiceCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.ServiceRepository)
+			uc := &GetServiceUC{Services: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetService) ||
+					errors.Is(tt.WantErr, uc_errors.ErrServiceNotFound)
+
+			if shouldCallRepo {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
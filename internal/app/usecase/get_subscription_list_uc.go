@@ -6,6 +6,7 @@ import (
 	"github.com/maket12/SubTrack/internal/app/dto"
 	"github.com/maket12/SubTrack/internal/app/mappers"
 	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
 	"github.com/maket12/SubTrack/internal/domain/filter"
 	"github.com/maket12/SubTrack/internal/domain/port"
 
@@ -55,11 +56,40 @@ func (uc *GetSubscriptionListUC) Execute(ctx context.Context, in dto.GetSubscrip
 		serviceNamePtr = &s
 	}
 
+	var categoryPtr *string
+	if in.Category != nil && *in.Category != "" {
+		c := *in.Category
+		categoryPtr = &c
+	}
+
+	var tagPtr *string
+	if in.Tag != nil && *in.Tag != "" {
+		t := *in.Tag
+		tagPtr = &t
+	}
+
+	var currencyPtr *entity.Currency
+	if in.Currency != nil && *in.Currency != "" {
+		c, ok := entity.ParseCurrency(*in.Currency)
+		if !ok {
+			return dto.GetSubscriptionListResponse{}, uc_errors.ErrInvalidCurrency
+		}
+		currencyPtr = &c
+	}
+
 	f := filter.ListFilter{
-		UserID:      uidPtr,
-		ServiceName: serviceNamePtr,
-		Limit:       limit,
-		Offset:      in.Offset,
+		UserID:         uidPtr,
+		ServiceName:    serviceNamePtr,
+		Category:       categoryPtr,
+		Tag:            tagPtr,
+		Currency:       currencyPtr,
+		InTrial:        in.InTrial,
+		Cancelled:      in.Cancelled,
+		Active:         in.Active,
+		ScheduledToEnd: in.ScheduledToEnd,
+		Pending:        in.Pending,
+		Limit:          limit,
+		Offset:         in.Offset,
 	}
 
 	/* ####################
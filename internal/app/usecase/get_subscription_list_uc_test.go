@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/maket12/SubTrack/internal/app/dto"
 	"github.com/maket12/SubTrack/internal/app/uc_errors"
 	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
 	"github.com/maket12/SubTrack/internal/domain/port/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +19,7 @@ import (
 type GetListCase struct {
 	Name       string
 	Input      dto.GetSubscriptionList
+	RepoInput  *filter.ListFilter
 	RepoOutput []entity.Subscription
 	Output     dto.GetSubscriptionListResponse
 	WantErr    error
@@ -68,6 +71,18 @@ var GetListCases = []GetListCase{
 		WantErr: uc_errors.ErrInvalidUserID,
 	},
 
+	{
+		Name: "invalid currency",
+		Input: dto.GetSubscriptionList{
+			UserID:      vPtr(uuid.New().String()),
+			ServiceName: vPtr("YandexMusic"),
+			Currency:    vPtr("RUR"),
+			Limit:       0,
+			Offset:      0,
+		},
+		WantErr: uc_errors.ErrInvalidCurrency,
+	},
+
 	{
 		Name: "repository error",
 		Input: dto.GetSubscriptionList{
@@ -90,45 +105,199 @@ var GetListCases = []GetListCase{
 		},
 		RepoOutput: []entity.Subscription{
 			{
-				ID:          1,
-				ServiceName: "YandexMusic",
-				Price:       450,
-				UserID:      uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
-				StartDate:   parseTime("29-11-2025"),
-				EndDate:     vPtr(parseTime("29-11-2026")),
+				ID:            1,
+				ServiceName:   "YandexMusic",
+				Price:         450,
+				Seats:         1,
+				Currency:      "RUB",
+				BillingPeriod: entity.BillingPeriodMonthly,
+				UserID:        uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
+				StartDate:     parseTime("29-11-2025"),
+				EndDate:       vPtr(parseTime("29-11-2026")),
 			},
 			{
-				ID:          2,
-				ServiceName: "YandexMusic",
-				Price:       200,
-				UserID:      uuid.MustParse("79acd52c-cacd-40d7-876b-2f131bdf3014"),
-				StartDate:   parseTime("29-11-2025"),
-				EndDate:     vPtr(parseTime("29-12-2025")),
+				ID:            2,
+				ServiceName:   "YandexMusic",
+				Price:         200,
+				Seats:         1,
+				Currency:      "RUB",
+				BillingPeriod: entity.BillingPeriodMonthly,
+				UserID:        uuid.MustParse("79acd52c-cacd-40d7-876b-2f131bdf3014"),
+				StartDate:     parseTime("29-11-2025"),
+				EndDate:       vPtr(parseTime("29-12-2025")),
 			},
 		},
 		Output: dto.GetSubscriptionListResponse{
 			Items: []dto.GetSubscriptionResponse{
 				{
-					ID:          1,
-					ServiceName: "YandexMusic",
-					Price:       450,
-					UserID:      "79acd47c-cacd-40d7-876b-2f131bdf3014",
-					StartDate:   "29-11-2025",
-					EndDate:     vPtr("29-11-2026"),
+					ID:             1,
+					ServiceName:    "YandexMusic",
+					Price:          450,
+					Seats:          1,
+					EffectivePrice: 450,
+					Currency:       "RUB",
+					BillingPeriod:  "monthly",
+					MonthlyPrice:   450,
+					UserID:         "79acd47c-cacd-40d7-876b-2f131bdf3014",
+					StartDate:      "29-11-2025",
+					EndDate:        vPtr("29-11-2026"),
 				},
 				{
-					ID:          2,
-					ServiceName: "YandexMusic",
-					Price:       200,
-					UserID:      "79acd52c-cacd-40d7-876b-2f131bdf3014",
-					StartDate:   "29-11-2025",
-					EndDate:     vPtr("29-12-2025"),
+					ID:             2,
+					ServiceName:    "YandexMusic",
+					Price:          200,
+					Seats:          1,
+					EffectivePrice: 200,
+					Currency:       "RUB",
+					BillingPeriod:  "monthly",
+					MonthlyPrice:   200,
+					UserID:         "79acd52c-cacd-40d7-876b-2f131bdf3014",
+					StartDate:      "29-11-2025",
+					EndDate:        vPtr("29-12-2025"),
 				},
 			},
 		},
 		WantErr: nil,
 		RepoErr: nil,
 	},
+
+	{
+		Name: "success trials only",
+		Input: dto.GetSubscriptionList{
+			InTrial: vPtr(true),
+			Limit:   5,
+		},
+		RepoInput: &filter.ListFilter{
+			InTrial: vPtr(true),
+			Limit:   5,
+		},
+		RepoOutput: []entity.Subscription{
+			{
+				ID:            3,
+				ServiceName:   "Kinopoisk",
+				Price:         399,
+				Seats:         1,
+				Currency:      "RUB",
+				BillingPeriod: entity.BillingPeriodMonthly,
+				UserID:        uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
+				StartDate:     parseTime("01-11-2025"),
+				TrialEndDate:  vPtr(parseTime("30-11-2025")),
+				TrialPrice:    1,
+			},
+		},
+		Output: dto.GetSubscriptionListResponse{
+			Items: []dto.GetSubscriptionResponse{
+				{
+					ID:             3,
+					ServiceName:    "Kinopoisk",
+					Price:          399,
+					Seats:          1,
+					EffectivePrice: 399,
+					Currency:       "RUB",
+					BillingPeriod:  "monthly",
+					MonthlyPrice:   399,
+					UserID:         "79acd47c-cacd-40d7-876b-2f131bdf3014",
+					StartDate:      "01-11-2025",
+					TrialEndDate:   vPtr("30-11-2025"),
+					TrialPrice:     1,
+				},
+			},
+		},
+	},
+
+	{
+		Name: "success cancelled and scheduled to end",
+		Input: dto.GetSubscriptionList{
+			Cancelled:      vPtr(true),
+			ScheduledToEnd: vPtr(true),
+		},
+		RepoInput: &filter.ListFilter{
+			Cancelled:      vPtr(true),
+			ScheduledToEnd: vPtr(true),
+			Limit:          10,
+		},
+		RepoOutput: []entity.Subscription{
+			{
+				ID:                 4,
+				ServiceName:        "Ivi",
+				Price:              299,
+				Seats:              1,
+				Currency:           "RUB",
+				BillingPeriod:      entity.BillingPeriodMonthly,
+				UserID:             uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
+				StartDate:          parseTime("01-11-2025"),
+				EndDate:            vPtr(parseTime("30-11-2026")),
+				Status:             entity.SubscriptionStatusActive,
+				CancelledAt:        vPtr(time.Date(2026, time.November, 10, 12, 30, 0, 0, time.UTC)),
+				CancellationReason: vPtr("not watching"),
+			},
+		},
+		Output: dto.GetSubscriptionListResponse{
+			Items: []dto.GetSubscriptionResponse{
+				{
+					ID:                 4,
+					ServiceName:        "Ivi",
+					Price:              299,
+					Seats:              1,
+					EffectivePrice:     299,
+					Currency:           "RUB",
+					BillingPeriod:      "monthly",
+					MonthlyPrice:       299,
+					UserID:             "79acd47c-cacd-40d7-876b-2f131bdf3014",
+					StartDate:          "01-11-2025",
+					EndDate:            vPtr("30-11-2026"),
+					Status:             "active",
+					CancelledAt:        vPtr("2026-11-10T12:30:00Z"),
+					CancellationReason: vPtr("not watching"),
+				},
+			},
+		},
+	},
+
+	{
+		Name: "success by category and tag",
+		Input: dto.GetSubscriptionList{
+			Category: vPtr("cloud"),
+			Tag:      vPtr("team-design"),
+		},
+		RepoInput: &filter.ListFilter{
+			Category: vPtr("cloud"),
+			Tag:      vPtr("team-design"),
+			Limit:    10,
+		},
+		RepoOutput: []entity.Subscription{
+			{
+				ID:            5,
+				ServiceName:   "Figma",
+				Category:      vPtr("cloud"),
+				Tags:          []string{"prod", "team-design"},
+				Price:         1200,
+				Seats:         1,
+				Currency:      "RUB",
+				BillingPeriod: entity.BillingPeriodMonthly,
+				UserID:        uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
+				StartDate:     parseTime("01-11-2025"),
+			},
+		},
+		Output: dto.GetSubscriptionListResponse{
+			Items: []dto.GetSubscriptionResponse{
+				{
+					ID:             5,
+					ServiceName:    "Figma",
+					Category:       vPtr("cloud"),
+					Tags:           []string{"prod", "team-design"},
+					Price:          1200,
+					Seats:          1,
+					EffectivePrice: 1200,
+					Currency:       "RUB",
+					BillingPeriod:  "monthly",
+					MonthlyPrice:   1200,
+					UserID:         "79acd47c-cacd-40d7-876b-2f131bdf3014",
+					StartDate:      "01-11-2025",
+				},
+			},
+		},
+	},
 }
 
 func TestGetSubscriptionListUC(t *testing.T) {
@@ -142,7 +311,11 @@ func TestGetSubscriptionListUC(t *testing.T) {
 					errors.Is(tt.WantErr, uc_errors.ErrGetSubscriptionList)
 
 			if shouldCallRepo {
-				repo.On("GetList", mock.Anything, mock.Anything).
+				var f any = mock.Anything
+				if tt.RepoInput != nil {
+					f = *tt.RepoInput
+				}
+				repo.On("GetList", mock.Anything, f).
 					Return(tt.RepoOutput, tt.RepoErr)
 			}
 
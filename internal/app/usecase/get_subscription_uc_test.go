@@ -50,24 +50,96 @@ var GetCases = []GetCase{
 		Name:  "success get",
 		Input: dto.GetSubscription{ID: 1},
 		RepoOutput: &entity.Subscription{
-			ID:          1,
-			ServiceName: "Netflix",
-			Price:       1000,
-			UserID:      uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
-			StartDate:   parseTime("01-01-2026"),
-			EndDate:     vPtr(parseTime("01-03-2026")),
+			ID:            1,
+			ServiceName:   "Netflix",
+			Price:         1000,
+			Seats:         1,
+			Currency:      "RUB",
+			BillingPeriod: entity.BillingPeriodMonthly,
+			UserID:        uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
+			StartDate:     parseTime("01-01-2026"),
+			EndDate:       vPtr(parseTime("01-03-2026")),
 		},
 		Output: dto.GetSubscriptionResponse{
-			ID:          1,
-			ServiceName: "Netflix",
-			Price:       1000,
-			UserID:      "79acd47c-cacd-40d7-876b-2f131bdf3014",
-			StartDate:   "01-01-2026",
-			EndDate:     vPtr("01-03-2026"),
+			ID:             1,
+			ServiceName:    "Netflix",
+			Price:          1000,
+			Seats:          1,
+			EffectivePrice: 1000,
+			Currency:       "RUB",
+			BillingPeriod:  "monthly",
+			MonthlyPrice:   1000,
+			UserID:         "79acd47c-cacd-40d7-876b-2f131bdf3014",
+			StartDate:      "01-01-2026",
+			EndDate:        vPtr("01-03-2026"),
 		},
 		WantErr: nil,
 		RepoErr: nil,
 	},
+
+	{
+		Name:  "success get yearly",
+		Input: dto.GetSubscription{ID: 2},
+		RepoOutput: &entity.Subscription{
+			ID:            2,
+			ServiceName:   "Notion",
+			Price:         12000,
+			Seats:         1,
+			Currency:      "RUB",
+			BillingPeriod: entity.BillingPeriodYearly,
+			UserID:        uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
+			StartDate:     parseTime("01-01-2026"),
+			EndDate:       nil,
+			NextRenewal:   vPtr(parseTime("01-01-2027")),
+		},
+		Output: dto.GetSubscriptionResponse{
+			ID:              2,
+			ServiceName:     "Notion",
+			Price:           12000,
+			Seats:           1,
+			EffectivePrice:  12000,
+			Currency:        "RUB",
+			BillingPeriod:   "yearly",
+			MonthlyPrice:    1000,
+			UserID:          "79acd47c-cacd-40d7-876b-2f131bdf3014",
+			StartDate:       "01-01-2026",
+			EndDate:         nil,
+			NextRenewalDate: vPtr("01-01-2027"),
+		},
+		WantErr: nil,
+		RepoErr: nil,
+	},
+
+	{
+		Name:  "success get paused",
+		Input: dto.GetSubscription{ID: 3},
+		RepoOutput: &entity.Subscription{
+			ID:            3,
+			ServiceName:   "WorldClass",
+			Price:         5000,
+			Seats:         1,
+			Currency:      "RUB",
+			BillingPeriod: entity.BillingPeriodMonthly,
+			UserID:        uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014"),
+			StartDate:     parseTime("01-01-2026"),
+			PausedAt:      vPtr(parseTime("01-06-2026")),
+			Status:        entity.SubscriptionStatusPaused,
+		},
+		Output: dto.GetSubscriptionResponse{
+			ID:             3,
+			ServiceName:    "WorldClass",
+			Price:          5000,
+			Seats:          1,
+			EffectivePrice: 5000,
+			Currency:       "RUB",
+			BillingPeriod:  "monthly",
+			MonthlyPrice:   5000,
+			UserID:         "79acd47c-cacd-40d7-876b-2f131bdf3014",
+			StartDate:      "01-01-2026",
+			Status:         "paused",
+			PausedSince:    vPtr("01-06-2026"),
+		},
+	},
 }
 
 func parseTime(t string) time.Time {
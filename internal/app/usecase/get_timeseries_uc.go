@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+// maxTimeseriesMonths bounds the number of points a series can have.
+const maxTimeseriesMonths = 120
+
+type GetTimeseriesUC struct {
+	Subscriptions port.SubscriptionRepository
+	Rates         port.ExchangeRateRepository
+}
+
+func (uc *GetTimeseriesUC) Execute(ctx context.Context, in dto.GetTimeseries) (dto.GetTimeseriesResponse, error) {
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	var uidPtr *uuid.UUID
+	if in.UserID != nil && *in.UserID != "" {
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			return dto.GetTimeseriesResponse{}, uc_errors.ErrInvalidUserID
+		}
+		uidPtr = &uid
+	}
+
+	var serviceNamePtr *string
+	if in.ServiceName != nil && *in.ServiceName != "" {
+		s := *in.ServiceName
+		serviceNamePtr = &s
+	}
+
+	var categoryPtr *string
+	if in.Category != nil && *in.Category != "" {
+		c := *in.Category
+		categoryPtr = &c
+	}
+
+	var tagPtr *string
+	if in.Tag != nil && *in.Tag != "" {
+		t := *in.Tag
+		tagPtr = &t
+	}
+
+	// the last twelve months up to today unless told otherwise
+	to, err := parseDayOrToday(in.To)
+	if err != nil {
+		return dto.GetTimeseriesResponse{}, uc_errors.ErrInvalidDate
+	}
+
+	from := time.Date(to.Year(), to.Month()-11, 1, 0, 0, 0, 0, time.UTC)
+	if in.From != nil && *in.From != "" {
+		from, err = time.Parse("02-01-2006", *in.From)
+		if err != nil {
+			return dto.GetTimeseriesResponse{}, uc_errors.ErrInvalidDate
+		}
+	}
+
+	if to.Before(from) {
+		return dto.GetTimeseriesResponse{}, uc_errors.ErrInvalidPeriod
+	}
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month()) + 1
+	if months > maxTimeseriesMonths {
+		return dto.GetTimeseriesResponse{}, uc_errors.ErrTimeseriesTooLong
+	}
+
+	var targetPtr *entity.Currency
+	if in.Currency != nil && *in.Currency != "" {
+		c, ok := entity.ParseCurrency(*in.Currency)
+		if !ok {
+			return dto.GetTimeseriesResponse{}, uc_errors.ErrInvalidCurrency
+		}
+		targetPtr = &c
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	sums, err := uc.Subscriptions.GetMonthlySeries(ctx, filter.SumFilter{
+		UserID:      uidPtr,
+		ServiceName: serviceNamePtr,
+		Category:    categoryPtr,
+		Tag:         tagPtr,
+		StartDate:   &from,
+		EndDate:     &to,
+	})
+	if err != nil {
+		return dto.GetTimeseriesResponse{}, uc_errors.Wrap(uc_errors.ErrGetTimeseries, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	points := make([]dto.TimeseriesPoint, 0)
+	var converted []float64
+
+	for _, s := range sums {
+		month := s.Month.Format("01-2006")
+		if len(points) == 0 || points[len(points)-1].Month != month {
+			points = append(points, dto.TimeseriesPoint{Month: month, Totals: []dto.Money{}})
+			converted = append(converted, 0)
+		}
+
+		// an empty currency marks a month without charges
+		if s.Currency == "" {
+			continue
+		}
+
+		i := len(points) - 1
+		points[i].Totals = append(points[i].Totals, dto.Money{Amount: s.Amount, Currency: string(s.Currency)})
+
+		if targetPtr != nil {
+			rate, err := monthlyRate(ctx, uc.Rates, s.Currency, *targetPtr, s.Month)
+			if err != nil {
+				return dto.GetTimeseriesResponse{}, err
+			}
+			converted[i] += float64(s.Amount) * rate
+		}
+	}
+
+	if targetPtr != nil {
+		for i := range points {
+			points[i].Total = &dto.Money{Amount: int(math.Round(converted[i])), Currency: string(*targetPtr)}
+		}
+	}
+
+	return dto.GetTimeseriesResponse{Points: points}, nil
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetTimeseriesCase struct {
+	Name       string
+	Input      dto.GetTimeseries
+	WantFrom   time.Time
+	WantTo     time.Time
+	RepoOutput []entity.MonthlyAmount
+	Rates      []rateCall
+	Output     dto.GetTimeseriesResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetTimeseriesCases = []GetTimeseriesCase{
+	{
+		Name:    "invalid user id",
+		Input:   dto.GetTimeseries{UserID: vPtr("not-a-uuid")},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "invalid from",
+		Input:   dto.GetTimeseries{From: vPtr("2025-01")},
+		WantErr: uc_errors.ErrInvalidDate,
+	},
+
+	{
+		Name:    "to before from",
+		Input:   dto.GetTimeseries{From: vPtr("01-03-2025"), To: vPtr("01-01-2025")},
+		WantErr: uc_errors.ErrInvalidPeriod,
+	},
+
+	{
+		Name:    "span too long",
+		Input:   dto.GetTimeseries{From: vPtr("01-01-0001"), To: vPtr("31-12-2025")},
+		WantErr: uc_errors.ErrTimeseriesTooLong,
+	},
+
+	{
+		Name:     "longest span",
+		Input:    dto.GetTimeseries{From: vPtr("01-01-2016"), To: vPtr("31-12-2025")},
+		WantFrom: parseTime("01-01-2016"),
+		WantTo:   parseTime("31-12-2025"),
+		Output:   dto.GetTimeseriesResponse{Points: []dto.TimeseriesPoint{}},
+	},
+
+	{
+		Name:    "invalid currency",
+		Input:   dto.GetTimeseries{Currency: vPtr("XXX")},
+		WantErr: uc_errors.ErrInvalidCurrency,
+	},
+
+	{
+		Name:     "repository error",
+		Input:    dto.GetTimeseries{From: vPtr("01-01-2025"), To: vPtr("31-03-2025")},
+		WantFrom: parseTime("01-01-2025"),
+		WantTo:   parseTime("31-03-2025"),
+		WantErr:  uc_errors.ErrGetTimeseries,
+		RepoErr:  errors.New("db error"),
+	},
+
+	{
+		Name:     "success with zero month",
+		Input:    dto.GetTimeseries{From: vPtr("01-01-2025"), To: vPtr("31-03-2025")},
+		WantFrom: parseTime("01-01-2025"),
+		WantTo:   parseTime("31-03-2025"),
+		RepoOutput: []entity.MonthlyAmount{
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 10, Currency: "USD"}},
+			{Month: parseTime("01-02-2025"), Money: entity.Money{Amount: 0, Currency: ""}},
+			{Month: parseTime("01-03-2025"), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+		},
+		Output: dto.GetTimeseriesResponse{
+			Points: []dto.TimeseriesPoint{
+				{Month: "01-2025", Totals: []dto.Money{{Amount: 800, Currency: "RUB"}, {Amount: 10, Currency: "USD"}}},
+				{Month: "02-2025", Totals: []dto.Money{}},
+				{Month: "03-2025", Totals: []dto.Money{{Amount: 800, Currency: "RUB"}}},
+			},
+		},
+	},
+
+	{
+		Name: "success converted",
+		Input: dto.GetTimeseries{
+			From:     vPtr("01-01-2025"),
+			To:       vPtr("28-02-2025"),
+			Currency: vPtr("RUB"),
+		},
+		WantFrom: parseTime("01-01-2025"),
+		WantTo:   parseTime("28-02-2025"),
+		RepoOutput: []entity.MonthlyAmount{
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 10, Currency: "USD"}},
+			{Month: parseTime("01-02-2025"), Money: entity.Money{Amount: 0, Currency: ""}},
+		},
+		Rates: []rateCall{
+			{From: "USD", On: "31-01-2025", Rate: 100},
+		},
+		Output: dto.GetTimeseriesResponse{
+			Points: []dto.TimeseriesPoint{
+				{
+					Month:  "01-2025",
+					Totals: []dto.Money{{Amount: 800, Currency: "RUB"}, {Amount: 10, Currency: "USD"}},
+					Total:  &dto.Money{Amount: 1800, Currency: "RUB"},
+				},
+				{
+					Month:  "02-2025",
+					Totals: []dto.Money{},
+					Total:  &dto.Money{Amount: 0, Currency: "RUB"},
+				},
+			},
+		},
+	},
+}
+
+func TestGetTimeseriesUC(t *testing.T) {
+	for _, tt := range GetTimeseriesCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			rates := new(mocks.ExchangeRateRepository)
+			uc := &GetTimeseriesUC{Subscriptions: repo, Rates: rates}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetTimeseries)
+
+			if shouldCallRepo {
+				repo.On("GetMonthlySeries", mock.Anything, mock.MatchedBy(func(f filter.SumFilter) bool {
+					return f.StartDate.Equal(tt.WantFrom) && f.EndDate.Equal(tt.WantTo)
+				})).Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			for _, rc := range tt.Rates {
+				rates.On("GetRate", mock.Anything, rc.From, entity.Currency(*tt.Input.Currency), parseTime(rc.On)).
+					Return(rc.Rate, rc.Err)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			rates.AssertExpectations(t)
+		})
+	}
+}
@@ -2,10 +2,13 @@ package usecase
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
 	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
 	"github.com/maket12/SubTrack/internal/domain/filter"
 	"github.com/maket12/SubTrack/internal/domain/port"
 
@@ -14,6 +17,7 @@ import (
 
 type GetTotalSumUC struct {
 	Subscriptions port.SubscriptionRepository
+	Rates         port.ExchangeRateRepository
 }
 
 func (uc *GetTotalSumUC) Execute(ctx context.Context, in dto.GetTotalSum) (dto.GetTotalSumResponse, error) {
@@ -39,6 +43,18 @@ func (uc *GetTotalSumUC) Execute(ctx context.Context, in dto.GetTotalSum) (dto.G
 		serviceNamePtr = &s
 	}
 
+	var categoryPtr *string
+	if in.Category != nil && *in.Category != "" {
+		c := *in.Category
+		categoryPtr = &c
+	}
+
+	var tagPtr *string
+	if in.Tag != nil && *in.Tag != "" {
+		t := *in.Tag
+		tagPtr = &t
+	}
+
 	var startPtr *time.Time
 	if in.StartDate != nil {
 		start, err := time.Parse("02-01-2006", *in.StartDate)
@@ -57,6 +73,28 @@ func (uc *GetTotalSumUC) Execute(ctx context.Context, in dto.GetTotalSum) (dto.G
 		endPtr = &t
 	}
 
+	if startPtr != nil && endPtr != nil && endPtr.Before(*startPtr) {
+		return dto.GetTotalSumResponse{}, uc_errors.ErrInvalidPeriod
+	}
+
+	var targetPtr *entity.Currency
+	if in.Currency != nil && *in.Currency != "" {
+		c, ok := entity.ParseCurrency(*in.Currency)
+		if !ok {
+			return dto.GetTotalSumResponse{}, uc_errors.ErrInvalidCurrency
+		}
+		targetPtr = &c
+	}
+
+	var groups []filter.SumGroup
+	if in.GroupBy != nil && *in.GroupBy != "" {
+		g, err := parseGroupBy(*in.GroupBy)
+		if err != nil {
+			return dto.GetTotalSumResponse{}, err
+		}
+		groups = g
+	}
+
 	/* ####################
 	   #	 Request      #
 	   ####################
@@ -64,14 +102,59 @@ func (uc *GetTotalSumUC) Execute(ctx context.Context, in dto.GetTotalSum) (dto.G
 	f := filter.SumFilter{
 		UserID:      uidPtr,
 		ServiceName: serviceNamePtr,
+		Category:    categoryPtr,
+		Tag:         tagPtr,
 		StartDate:   startPtr,
 		EndDate:     endPtr,
 	}
 
-	sum, err := uc.Subscriptions.GetTotalSum(ctx, f)
+	totals, err := uc.Subscriptions.GetTotalSum(ctx, f)
 	if err != nil {
 		return dto.GetTotalSumResponse{}, uc_errors.Wrap(uc_errors.ErrGetTotalSum, err)
 	}
 
-	return dto.GetTotalSumResponse{TotalSum: sum}, nil
+	resp := dto.GetTotalSumResponse{Totals: mappers.MapIntoMoneyDTOs(totals)}
+
+	/* ####################
+	   #	Conversion    #
+	   ####################
+	*/
+	if targetPtr != nil {
+		sums, err := uc.Subscriptions.GetMonthlySums(ctx, f)
+		if err != nil {
+			return dto.GetTotalSumResponse{}, uc_errors.Wrap(uc_errors.ErrGetTotalSum, err)
+		}
+
+		total, err := convertMonthlySums(ctx, uc.Rates, sums, *targetPtr)
+		if err != nil {
+			return dto.GetTotalSumResponse{}, err
+		}
+
+		resp.Total = &dto.Money{Amount: total.Amount, Currency: string(total.Currency)}
+	}
+
+	/* ####################
+	   #	 Grouping     #
+	   ####################
+	*/
+	if len(groups) > 0 {
+		// converting needs every row to fall into a single month
+		f.GroupBy = groups
+		if targetPtr != nil && !slices.Contains(groups, filter.SumGroupMonth) {
+			f.GroupBy = append(slices.Clone(groups), filter.SumGroupMonth)
+		}
+
+		rows, err := uc.Subscriptions.GetGroupedSums(ctx, f)
+		if err != nil {
+			return dto.GetTotalSumResponse{}, uc_errors.Wrap(uc_errors.ErrGetTotalSum, err)
+		}
+
+		buckets, err := groupTotals(ctx, uc.Rates, rows, groups, targetPtr)
+		if err != nil {
+			return dto.GetTotalSumResponse{}, err
+		}
+		resp.Buckets = buckets
+	}
+
+	return resp, nil
 }
@@ -2,12 +2,15 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/maket12/SubTrack/internal/app/dto"
 	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
 	"github.com/maket12/SubTrack/internal/domain/port/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,7 +19,7 @@ import (
 type GetTotalSumCase struct {
 	Name       string
 	Input      dto.GetTotalSum
-	RepoOutput int
+	RepoOutput []entity.Money
 	Output     dto.GetTotalSumResponse
 	WantErr    error
 	RepoErr    error
@@ -67,6 +70,17 @@ var GetTotalSumCases = []GetTotalSumCase{
 		WantErr: uc_errors.ErrInvalidDate,
 	},
 
+	{
+		Name: "end date before start date",
+		Input: dto.GetTotalSum{
+			UserID:      vPtr(uuid.New().String()),
+			ServiceName: vPtr("Kinopoisk"),
+			StartDate:   vPtr("01-06-2025"),
+			EndDate:     vPtr("01-05-2025"),
+		},
+		WantErr: uc_errors.ErrInvalidPeriod,
+	},
+
 	{
 		Name: "repository error",
 		Input: dto.GetTotalSum{
@@ -87,8 +101,29 @@ var GetTotalSumCases = []GetTotalSumCase{
 			StartDate:   vPtr("04-04-2025"),
 			EndDate:     vPtr("04-05-2025"),
 		},
-		RepoOutput: 10250,
-		Output:     dto.GetTotalSumResponse{TotalSum: 10250},
+		RepoOutput: []entity.Money{
+			{Amount: 35, Currency: "EUR"},
+			{Amount: 10250, Currency: "RUB"},
+		},
+		Output: dto.GetTotalSumResponse{
+			Totals: []dto.Money{
+				{Amount: 35, Currency: "EUR"},
+				{Amount: 10250, Currency: "RUB"},
+			},
+		},
+		WantErr: nil,
+		RepoErr: nil,
+	},
+
+	{
+		Name: "success get empty total sum",
+		Input: dto.GetTotalSum{
+			UserID:    vPtr(uuid.New().String()),
+			StartDate: vPtr("01-01-2025"),
+			EndDate:   vPtr("31-12-2025"),
+		},
+		RepoOutput: nil,
+		Output:     dto.GetTotalSumResponse{Totals: []dto.Money{}},
 		WantErr:    nil,
 		RepoErr:    nil,
 	},
@@ -124,3 +159,262 @@ func TestGetTotalSumUC(t *testing.T) {
 		})
 	}
 }
+
+type rateCall struct {
+	From entity.Currency
+	On   string
+	Rate float64
+	Err  error
+}
+
+type GetConvertedTotalSumCase struct {
+	Name          string
+	Input         dto.GetTotalSum
+	RepoOutput    []entity.Money
+	MonthlyOutput []entity.MonthlyAmount
+	Rates         []rateCall
+	Output        dto.GetTotalSumResponse
+	WantErr       error
+}
+
+var GetConvertedTotalSumCases = []GetConvertedTotalSumCase{
+	{
+		Name: "invalid target currency",
+		Input: dto.GetTotalSum{
+			StartDate: vPtr("01-01-2025"),
+			EndDate:   vPtr("28-02-2025"),
+			Currency:  vPtr("ABC"),
+		},
+		WantErr: uc_errors.ErrInvalidCurrency,
+	},
+
+	{
+		Name: "exchange rate not found",
+		Input: dto.GetTotalSum{
+			StartDate: vPtr("01-01-2025"),
+			EndDate:   vPtr("31-01-2025"),
+			Currency:  vPtr("RUB"),
+		},
+		RepoOutput: []entity.Money{{Amount: 10, Currency: "EUR"}},
+		MonthlyOutput: []entity.MonthlyAmount{
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 10, Currency: "EUR"}},
+		},
+		Rates: []rateCall{
+			{From: "EUR", On: "31-01-2025", Err: sql.ErrNoRows},
+		},
+		WantErr: uc_errors.ErrExchangeRateNotFound,
+	},
+
+	{
+		Name: "success convert each month at its rate",
+		Input: dto.GetTotalSum{
+			StartDate: vPtr("01-01-2025"),
+			EndDate:   vPtr("28-02-2025"),
+			Currency:  vPtr("rub"),
+		},
+		RepoOutput: []entity.Money{
+			{Amount: 20, Currency: "EUR"},
+			{Amount: 3000, Currency: "RUB"},
+		},
+		MonthlyOutput: []entity.MonthlyAmount{
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 10, Currency: "EUR"}},
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 1500, Currency: "RUB"}},
+			{Month: parseTime("01-02-2025"), Money: entity.Money{Amount: 10, Currency: "EUR"}},
+			{Month: parseTime("01-02-2025"), Money: entity.Money{Amount: 1500, Currency: "RUB"}},
+		},
+		Rates: []rateCall{
+			{From: "EUR", On: "31-01-2025", Rate: 100},
+			{From: "EUR", On: "28-02-2025", Rate: 90.5},
+		},
+		Output: dto.GetTotalSumResponse{
+			Totals: []dto.Money{
+				{Amount: 20, Currency: "EUR"},
+				{Amount: 3000, Currency: "RUB"},
+			},
+			Total: &dto.Money{Amount: 1000 + 905 + 3000, Currency: "RUB"},
+		},
+	},
+}
+
+func TestGetTotalSumUC_Conversion(t *testing.T) {
+	for _, tt := range GetConvertedTotalSumCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			rates := new(mocks.ExchangeRateRepository)
+			uc := &GetTotalSumUC{Subscriptions: repo, Rates: rates}
+
+			if tt.MonthlyOutput != nil {
+				repo.On("GetTotalSum", mock.Anything, mock.Anything).
+					Return(tt.RepoOutput, nil)
+				repo.On("GetMonthlySums", mock.Anything, mock.Anything).
+					Return(tt.MonthlyOutput, nil)
+			}
+
+			target, _ := entity.ParseCurrency(*tt.Input.Currency)
+			for _, rc := range tt.Rates {
+				rates.On("GetRate", mock.Anything, rc.From, target, parseTime(rc.On)).
+					Return(rc.Rate, rc.Err)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			rates.AssertExpectations(t)
+		})
+	}
+}
+
+type GetGroupedTotalSumCase struct {
+	Name          string
+	Input         dto.GetTotalSum
+	WantGroupBy   []filter.SumGroup
+	RepoOutput    []entity.Money
+	MonthlyOutput []entity.MonthlyAmount
+	GroupedOutput []entity.GroupedAmount
+	Rates         []rateCall
+	Output        dto.GetTotalSumResponse
+	WantErr       error
+}
+
+var GetGroupedTotalSumCases = []GetGroupedTotalSumCase{
+	{
+		Name: "unknown group",
+		Input: dto.GetTotalSum{
+			GroupBy: vPtr("service_name,currency"),
+		},
+		WantErr: uc_errors.ErrInvalidGroupBy,
+	},
+
+	{
+		Name: "success per service per month",
+		Input: dto.GetTotalSum{
+			StartDate: vPtr("01-01-2025"),
+			EndDate:   vPtr("28-02-2025"),
+			GroupBy:   vPtr("service_name, month,service_name"),
+		},
+		WantGroupBy: []filter.SumGroup{filter.SumGroupServiceName, filter.SumGroupMonth},
+		RepoOutput: []entity.Money{
+			{Amount: 1600, Currency: "RUB"},
+			{Amount: 20, Currency: "USD"},
+		},
+		GroupedOutput: []entity.GroupedAmount{
+			{ServiceName: vPtr("Netflix"), Month: vPtr(parseTime("01-01-2025")), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{ServiceName: vPtr("Netflix"), Month: vPtr(parseTime("01-02-2025")), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{ServiceName: vPtr("Spotify"), Month: vPtr(parseTime("01-01-2025")), Money: entity.Money{Amount: 10, Currency: "USD"}},
+			{ServiceName: vPtr("Spotify"), Month: vPtr(parseTime("01-02-2025")), Money: entity.Money{Amount: 10, Currency: "USD"}},
+		},
+		Output: dto.GetTotalSumResponse{
+			Totals: []dto.Money{
+				{Amount: 1600, Currency: "RUB"},
+				{Amount: 20, Currency: "USD"},
+			},
+			Buckets: []dto.TotalBucket{
+				{ServiceName: vPtr("Netflix"), Month: vPtr("01-2025"), Totals: []dto.Money{{Amount: 800, Currency: "RUB"}}},
+				{ServiceName: vPtr("Netflix"), Month: vPtr("02-2025"), Totals: []dto.Money{{Amount: 800, Currency: "RUB"}}},
+				{ServiceName: vPtr("Spotify"), Month: vPtr("01-2025"), Totals: []dto.Money{{Amount: 10, Currency: "USD"}}},
+				{ServiceName: vPtr("Spotify"), Month: vPtr("02-2025"), Totals: []dto.Money{{Amount: 10, Currency: "USD"}}},
+			},
+		},
+	},
+
+	{
+		Name: "success per user converted",
+		Input: dto.GetTotalSum{
+			StartDate: vPtr("01-01-2025"),
+			EndDate:   vPtr("28-02-2025"),
+			Currency:  vPtr("RUB"),
+			GroupBy:   vPtr("user_id"),
+		},
+		WantGroupBy: []filter.SumGroup{filter.SumGroupUserID, filter.SumGroupMonth},
+		RepoOutput: []entity.Money{
+			{Amount: 1600, Currency: "RUB"},
+			{Amount: 20, Currency: "USD"},
+		},
+		MonthlyOutput: []entity.MonthlyAmount{
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{Month: parseTime("01-01-2025"), Money: entity.Money{Amount: 10, Currency: "USD"}},
+			{Month: parseTime("01-02-2025"), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{Month: parseTime("01-02-2025"), Money: entity.Money{Amount: 10, Currency: "USD"}},
+		},
+		GroupedOutput: []entity.GroupedAmount{
+			{UserID: vPtr(uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014")), Month: vPtr(parseTime("01-01-2025")), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{UserID: vPtr(uuid.MustParse("79acd47c-cacd-40d7-876b-2f131bdf3014")), Month: vPtr(parseTime("01-02-2025")), Money: entity.Money{Amount: 800, Currency: "RUB"}},
+			{UserID: vPtr(uuid.MustParse("89acd47c-cacd-40d7-876b-2f131bdf3014")), Month: vPtr(parseTime("01-01-2025")), Money: entity.Money{Amount: 10, Currency: "USD"}},
+			{UserID: vPtr(uuid.MustParse("89acd47c-cacd-40d7-876b-2f131bdf3014")), Month: vPtr(parseTime("01-02-2025")), Money: entity.Money{Amount: 10, Currency: "USD"}},
+		},
+		Rates: []rateCall{
+			{From: "USD", On: "31-01-2025", Rate: 100},
+			{From: "USD", On: "28-02-2025", Rate: 90},
+		},
+		Output: dto.GetTotalSumResponse{
+			Totals: []dto.Money{
+				{Amount: 1600, Currency: "RUB"},
+				{Amount: 20, Currency: "USD"},
+			},
+			Total: &dto.Money{Amount: 3500, Currency: "RUB"},
+			Buckets: []dto.TotalBucket{
+				{
+					UserID: vPtr("79acd47c-cacd-40d7-876b-2f131bdf3014"),
+					Totals: []dto.Money{{Amount: 1600, Currency: "RUB"}},
+					Total:  &dto.Money{Amount: 1600, Currency: "RUB"},
+				},
+				{
+					UserID: vPtr("89acd47c-cacd-40d7-876b-2f131bdf3014"),
+					Totals: []dto.Money{{Amount: 20, Currency: "USD"}},
+					Total:  &dto.Money{Amount: 1900, Currency: "RUB"},
+				},
+			},
+		},
+	},
+}
+
+func TestGetTotalSumUC_Grouped(t *testing.T) {
+	for _, tt := range GetGroupedTotalSumCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			rates := new(mocks.ExchangeRateRepository)
+			uc := &GetTotalSumUC{Subscriptions: repo, Rates: rates}
+
+			if tt.GroupedOutput != nil {
+				repo.On("GetTotalSum", mock.Anything, mock.Anything).
+					Return(tt.RepoOutput, nil)
+				repo.On("GetGroupedSums", mock.Anything, mock.MatchedBy(func(f filter.SumFilter) bool {
+					return assert.ObjectsAreEqual(tt.WantGroupBy, f.GroupBy)
+				})).Return(tt.GroupedOutput, nil)
+			}
+			if tt.MonthlyOutput != nil {
+				repo.On("GetMonthlySums", mock.Anything, mock.Anything).
+					Return(tt.MonthlyOutput, nil)
+			}
+
+			// the grand total and the buckets convert the same months
+			for _, rc := range tt.Rates {
+				rates.On("GetRate", mock.Anything, rc.From, entity.Currency(*tt.Input.Currency), parseTime(rc.On)).
+					Return(rc.Rate, rc.Err)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			rates.AssertExpectations(t)
+		})
+	}
+}
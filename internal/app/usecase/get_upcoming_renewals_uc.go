@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+// maxWithinDays caps how far ahead upcoming renewals are looked up.
+const maxWithinDays = 366
+
+type GetUpcomingRenewalsUC struct {
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *GetUpcomingRenewalsUC) Execute(ctx context.Context, in dto.GetUpcomingRenewals) (dto.GetUpcomingRenewalsResponse, error) {
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	var uidPtr *uuid.UUID
+	if in.UserID != nil && *in.UserID != "" {
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			return dto.GetUpcomingRenewalsResponse{}, uc_errors.ErrInvalidUserID
+		}
+		uidPtr = &uid
+	}
+
+	days := 30
+	if in.Within != nil && *in.Within != "" {
+		d, ok := parseWithin(*in.Within)
+		if !ok {
+			return dto.GetUpcomingRenewalsResponse{}, uc_errors.ErrInvalidWithin
+		}
+		days = d
+	}
+
+	from := today()
+	to := from.AddDate(0, 0, days)
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	renewals, err := uc.Subscriptions.GetRenewals(ctx, filter.RenewalFilter{
+		UserID: uidPtr,
+		From:   from,
+		To:     to,
+	})
+	if err != nil {
+		return dto.GetUpcomingRenewalsResponse{}, uc_errors.Wrap(uc_errors.ErrGetUpcomingRenewals, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoUpcomingRenewalsDTO(from, to, renewals), nil
+}
+
+// parseWithin reads a window such as "30d" or "4w" as a number of days.
+func parseWithin(s string) (int, bool) {
+	unit := 1
+	switch {
+	case strings.HasSuffix(s, "d"):
+	case strings.HasSuffix(s, "w"):
+		unit = 7
+	default:
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n <= 0 || n*unit > maxWithinDays {
+		return 0, false
+	}
+	return n * unit, true
+}
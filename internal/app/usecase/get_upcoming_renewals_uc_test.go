@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetUpcomingRenewalsCase struct {
+	Name       string
+	Input      dto.GetUpcomingRenewals
+	WantDays   int
+	RepoOutput []entity.Renewal
+	Output     []dto.UpcomingRenewal
+	WantErr    error
+	RepoErr    error
+}
+
+var GetUpcomingRenewalsCases = []GetUpcomingRenewalsCase{
+	{
+		Name:    "invalid user id",
+		Input:   dto.GetUpcomingRenewals{UserID: vPtr("not-a-uuid")},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "invalid within unit",
+		Input:   dto.GetUpcomingRenewals{Within: vPtr("1m")},
+		WantErr: uc_errors.ErrInvalidWithin,
+	},
+
+	{
+		Name:    "invalid within number",
+		Input:   dto.GetUpcomingRenewals{Within: vPtr("-5d")},
+		WantErr: uc_errors.ErrInvalidWithin,
+	},
+
+	{
+		Name:    "within too long",
+		Input:   dto.GetUpcomingRenewals{Within: vPtr("60w")},
+		WantErr: uc_errors.ErrInvalidWithin,
+	},
+
+	{
+		Name:     "repository error",
+		Input:    dto.GetUpcomingRenewals{},
+		WantDays: 30,
+		WantErr:  uc_errors.ErrGetUpcomingRenewals,
+		RepoErr:  errors.New("db error"),
+	},
+
+	{
+		Name:     "success default window",
+		Input:    dto.GetUpcomingRenewals{UserID: vPtr(uuid.New().String())},
+		WantDays: 30,
+		Output:   []dto.UpcomingRenewal{},
+	},
+
+	{
+		Name:     "success weeks",
+		Input:    dto.GetUpcomingRenewals{Within: vPtr("2w")},
+		WantDays: 14,
+		RepoOutput: []entity.Renewal{
+			{
+				SubscriptionID: 1,
+				ServiceName:    "Netflix",
+				Date:           parseTime("03-02-2026"),
+				Money:          entity.Money{Amount: 799, Currency: "RUB"},
+			},
+			{
+				SubscriptionID: 2,
+				ServiceName:    "Spotify",
+				Date:           parseTime("10-02-2026"),
+				Money:          entity.Money{Amount: 11, Currency: "USD"},
+			},
+		},
+		Output: []dto.UpcomingRenewal{
+			{
+				SubscriptionID: 1,
+				ServiceName:    "Netflix",
+				Date:           "03-02-2026",
+				AmountDue:      dto.Money{Amount: 799, Currency: "RUB"},
+			},
+			{
+				SubscriptionID: 2,
+				ServiceName:    "Spotify",
+				Date:           "10-02-2026",
+				AmountDue:      dto.Money{Amount: 11, Currency: "USD"},
+			},
+		},
+	},
+}
+
+func TestGetUpcomingRenewalsUC(t *testing.T) {
+	for _, tt := range GetUpcomingRenewalsCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			uc := &GetUpcomingRenewalsUC{Subscriptions: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetUpcomingRenewals)
+
+			from := today()
+			to := from.AddDate(0, 0, tt.WantDays)
+
+			if shouldCallRepo {
+				repo.On("GetRenewals", mock.Anything, mock.MatchedBy(func(f filter.RenewalFilter) bool {
+					return f.From.Equal(from) && f.To.Equal(to) &&
+						(f.UserID == nil) == (tt.Input.UserID == nil)
+				})).Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.GetUpcomingRenewalsResponse{
+					From:  from.Format("02-01-2006"),
+					To:    to.Format("02-01-2006"),
+					Items: tt.Output,
+				}, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetVendorListUC struct {
+	Vendors port.VendorRepository
+}
+
+func (uc *GetVendorListUC) Execute(ctx context.Context, in dto.GetVendorList) (dto.GetVendorListResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.Limit < 0 {
+		return dto.GetVendorListResponse{}, uc_errors.ErrInvalidLimit
+	}
+	if in.Offset < 0 {
+		return dto.GetVendorListResponse{}, uc_errors.ErrInvalidOffset
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	vendors, err := uc.Vendors.GetList(ctx, filter.VendorFilter{
+		Limit:  limit,
+		Offset: in.Offset,
+	})
+	if err != nil {
+		return dto.GetVendorListResponse{}, uc_errors.Wrap(uc_errors.ErrGetVendorList, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetVendorListDTO(vendors), nil
+}
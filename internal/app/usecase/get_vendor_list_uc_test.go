@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetVendorListCase struct {
+	Name       string
+	Input      dto.GetVendorList
+	WantFilter filter.VendorFilter
+	RepoOutput []entity.Vendor
+	Output     dto.GetVendorListResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetVendorListCases = []GetVendorListCase{
+	{
+		Name:    "negative limit",
+		Input:   dto.GetVendorList{Limit: -1},
+		WantErr: uc_errors.ErrInvalidLimit,
+	},
+
+	{
+		Name:    "negative offset",
+		Input:   dto.GetVendorList{Offset: -1},
+		WantErr: uc_errors.ErrInvalidOffset,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.GetVendorList{},
+		WantFilter: filter.VendorFilter{Limit: 100},
+		WantErr:    uc_errors.ErrGetVendorList,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success",
+		Input:      dto.GetVendorList{Limit: 10, Offset: 5},
+		WantFilter: filter.VendorFilter{Limit: 10, Offset: 5},
+		RepoOutput: []entity.Vendor{
+			{ID: 1, Name: "Adobe", Notes: vPtr("renews every March")},
+			{ID: 2, Name: "Atlassian"},
+		},
+		Output: dto.GetVendorListResponse{
+			Vendors: []dto.GetVendorResponse{
+				{ID: 1, Name: "Adobe", Notes: vPtr("renews every March")},
+				{ID: 2, Name: "Atlassian"},
+			},
+		},
+	},
+}
+
+func TestGetVendorListUC(t *testing.T) {
+	for _, tt := range GetVendorListCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.VendorRepository)
+			uc := &GetVendorListUC{Vendors: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetVendorList)
+
+			if shouldCallRepo {
+				repo.On("GetList", mock.Anything, tt.WantFilter).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
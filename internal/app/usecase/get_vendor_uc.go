@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type GetVendorUC struct {
+	Vendors port.VendorRepository
+}
+
+func (uc *GetVendorUC) Execute(ctx context.Context, in dto.GetVendor) (dto.GetVendorResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.GetVendorResponse{}, uc_errors.ErrInvalidVendorID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	v, err := uc.Vendors.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetVendorResponse{}, uc_errors.Wrap(uc_errors.ErrVendorNotFound, err)
+		}
+		return dto.GetVendorResponse{}, uc_errors.Wrap(uc_errors.ErrGetVendor, err)
+	}
+
+	/* ####################
+	   #	 Mapping      #
+	   ####################
+	*/
+	return mappers.MapIntoGetVendorDTO(v), nil
+}
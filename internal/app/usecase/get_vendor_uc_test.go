@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetVendorCase struct {
+	Name       string
+	Input      dto.GetVendor
+	RepoOutput *entity.Vendor
+	Output     dto.GetVendorResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var GetVendorCases = []GetVendorCase{
+	{
+		Name:    "invalid vendor id",
+		Input:   dto.GetVendor{ID: -1},
+		WantErr: uc_errors.ErrInvalidVendorID,
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.GetVendor{ID: 1},
+		WantErr: uc_errors.ErrVendorNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.GetVendor{ID: 1},
+		WantErr: uc_errors.ErrGetVendor,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:       "success",
+		Input:      dto.GetVendor{ID: 1},
+		RepoOutput: &entity.Vendor{ID: 1, Name: "Atlassian", Contact: vPtr("billing@atlassian.com"), AccountManager: vPtr("Jane Roe")},
+		Output:     dto.GetVendorResponse{ID: 1, Name: "Atlassian", Contact: vPtr("billing@atlassian.com"), AccountManager: vPtr("Jane Roe")},
+	},
+}
+
+func TestGetVendorUC(t *testing.T) {
+	for _, tt := range GetVendorCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.VendorRepository)
+			uc := &GetVendorUC{Vendors: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrGetVendor) ||
+					errors.Is(tt.WantErr, uc_errors.ErrVendorNotFound)
+
+			if shouldCallRepo {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.RepoOutput, tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
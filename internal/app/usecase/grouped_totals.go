@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+// parseGroupBy reads a comma separated list of breakdown keys, ignoring
+// repeats.
+func parseGroupBy(in string) ([]filter.SumGroup, error) {
+	var groups []filter.SumGroup
+
+	for _, part := range strings.Split(in, ",") {
+		g := filter.SumGroup(strings.TrimSpace(part))
+		switch g {
+		case filter.SumGroupServiceName, filter.SumGroupUserID, filter.SumGroupMonth:
+		default:
+			return nil, uc_errors.ErrInvalidGroupBy
+		}
+		if !slices.Contains(groups, g) {
+			groups = append(groups, g)
+		}
+	}
+
+	return groups, nil
+}
+
+type bucketKey struct {
+	serviceName string
+	userID      uuid.UUID
+	month       time.Time
+}
+
+type bucket struct {
+	row       entity.GroupedAmount
+	totals    map[entity.Currency]int
+	converted float64
+}
+
+// groupTotals folds grouped amounts into one bucket per combination of the
+// requested keys, keeping the order of rows. With a target currency, rows must
+// also be grouped by month, so that each is converted at its month's rate.
+func groupTotals(
+	ctx context.Context,
+	rates port.ExchangeRateRepository,
+	rows []entity.GroupedAmount,
+	groups []filter.SumGroup,
+	target *entity.Currency,
+) ([]dto.TotalBucket, error) {
+	var order []*bucket
+	index := make(map[bucketKey]*bucket)
+
+	for _, r := range rows {
+		var k bucketKey
+		for _, g := range groups {
+			switch g {
+			case filter.SumGroupServiceName:
+				k.serviceName = *r.ServiceName
+			case filter.SumGroupUserID:
+				k.userID = *r.UserID
+			case filter.SumGroupMonth:
+				k.month = *r.Month
+			}
+		}
+
+		b, ok := index[k]
+		if !ok {
+			b = &bucket{row: r, totals: make(map[entity.Currency]int)}
+			index[k] = b
+			order = append(order, b)
+		}
+		b.totals[r.Currency] += r.Amount
+
+		if target != nil {
+			rate, err := monthlyRate(ctx, rates, r.Currency, *target, *r.Month)
+			if err != nil {
+				return nil, err
+			}
+			b.converted += float64(r.Amount) * rate
+		}
+	}
+
+	buckets := make([]dto.TotalBucket, 0, len(order))
+	for _, b := range order {
+		out := dto.TotalBucket{}
+		for _, g := range groups {
+			switch g {
+			case filter.SumGroupServiceName:
+				out.ServiceName = b.row.ServiceName
+			case filter.SumGroupUserID:
+				uid := b.row.UserID.String()
+				out.UserID = &uid
+			case filter.SumGroupMonth:
+				month := b.row.Month.Format("01-2006")
+				out.Month = &month
+			}
+		}
+
+		totals := make([]entity.Money, 0, len(b.totals))
+		for c, amount := range b.totals {
+			totals = append(totals, entity.Money{Amount: amount, Currency: c})
+		}
+		sort.Slice(totals, func(i, j int) bool { return totals[i].Currency < totals[j].Currency })
+		out.Totals = mappers.MapIntoMoneyDTOs(totals)
+
+		if target != nil {
+			out.Total = &dto.Money{Amount: int(math.Round(b.converted)), Currency: string(*target)}
+		}
+
+		buckets = append(buckets, out)
+	}
+
+	return buckets, nil
+}
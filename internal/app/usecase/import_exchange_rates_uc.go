@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type ImportExchangeRatesUC struct {
+	Rates port.ExchangeRateRepository
+}
+
+func (uc *ImportExchangeRatesUC) Execute(ctx context.Context, in dto.ImportExchangeRates) (dto.ImportExchangeRatesResponse, error) {
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	r := csv.NewReader(in.Data)
+	r.FieldsPerRecord = 4
+	r.TrimLeadingSpace = true
+
+	var rates []entity.ExchangeRate
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return dto.ImportExchangeRatesResponse{}, fmt.Errorf("%w: line %d", uc_errors.ErrInvalidExchangeRates, line)
+		}
+		if line == 1 && strings.EqualFold(record[0], "date") {
+			continue
+		}
+
+		rate, err := parseExchangeRate(record)
+		if err != nil {
+			return dto.ImportExchangeRatesResponse{}, fmt.Errorf("%w: line %d: %v", uc_errors.ErrInvalidExchangeRates, line, err)
+		}
+		rates = append(rates, rate)
+	}
+
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if len(rates) == 0 {
+		return dto.ImportExchangeRatesResponse{}, uc_errors.ErrEmptyExchangeRates
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.Rates.Save(ctx, rates); err != nil {
+		return dto.ImportExchangeRatesResponse{}, uc_errors.Wrap(uc_errors.ErrSaveExchangeRates, err)
+	}
+
+	return dto.ImportExchangeRatesResponse{Imported: len(rates)}, nil
+}
+
+func parseExchangeRate(record []string) (entity.ExchangeRate, error) {
+	date, err := time.Parse("02-01-2006", record[0])
+	if err != nil {
+		return entity.ExchangeRate{}, uc_errors.ErrInvalidDate
+	}
+
+	base, ok := entity.ParseCurrency(record[1])
+	if !ok {
+		return entity.ExchangeRate{}, uc_errors.ErrInvalidCurrency
+	}
+
+	quote, ok := entity.ParseCurrency(record[2])
+	if !ok {
+		return entity.ExchangeRate{}, uc_errors.ErrInvalidCurrency
+	}
+	if base == quote {
+		return entity.ExchangeRate{}, errors.New("base and quote currencies must differ")
+	}
+
+	rate, err := strconv.ParseFloat(record[3], 64)
+	if err != nil || rate <= 0 {
+		return entity.ExchangeRate{}, errors.New("rate must be a positive number")
+	}
+
+	return entity.ExchangeRate{
+		Date:  date,
+		Base:  base,
+		Quote: quote,
+		Rate:  rate,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ImportRatesCase struct {
+	Name      string
+	Input     string
+	SaveInput []entity.ExchangeRate
+	Output    dto.ImportExchangeRatesResponse
+	WantErr   error
+	RepoErr   error
+}
+
+var ImportRatesCases = []ImportRatesCase{
+	{
+		Name:    "empty file",
+		Input:   "",
+		WantErr: uc_errors.ErrEmptyExchangeRates,
+	},
+
+	{
+		Name:    "header only",
+		Input:   "date,base,quote,rate\n",
+		WantErr: uc_errors.ErrEmptyExchangeRates,
+	},
+
+	{
+		Name:    "wrong number of fields",
+		Input:   "01-01-2025,EUR,RUB\n",
+		WantErr: uc_errors.ErrInvalidExchangeRates,
+	},
+
+	{
+		Name:    "invalid date",
+		Input:   "2025-01-01,EUR,RUB,100\n",
+		WantErr: uc_errors.ErrInvalidExchangeRates,
+	},
+
+	{
+		Name:    "unknown currency",
+		Input:   "01-01-2025,EUR,XXY,100\n",
+		WantErr: uc_errors.ErrInvalidExchangeRates,
+	},
+
+	{
+		Name:    "same currencies",
+		Input:   "01-01-2025,EUR,EUR,1\n",
+		WantErr: uc_errors.ErrInvalidExchangeRates,
+	},
+
+	{
+		Name:    "negative rate",
+		Input:   "01-01-2025,EUR,RUB,-5\n",
+		WantErr: uc_errors.ErrInvalidExchangeRates,
+	},
+
+	{
+		Name:  "repository error",
+		Input: "01-01-2025,EUR,RUB,100\n",
+		SaveInput: []entity.ExchangeRate{
+			{Date: parseTime("01-01-2025"), Base: "EUR", Quote: "RUB", Rate: 100},
+		},
+		WantErr: uc_errors.ErrSaveExchangeRates,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "success import",
+		Input: "date,base,quote,rate\n01-01-2025,EUR,RUB,100.5\n01-02-2025, usd, rub, 90\n",
+		SaveInput: []entity.ExchangeRate{
+			{Date: parseTime("01-01-2025"), Base: "EUR", Quote: "RUB", Rate: 100.5},
+			{Date: parseTime("01-02-2025"), Base: "USD", Quote: "RUB", Rate: 90},
+		},
+		Output: dto.ImportExchangeRatesResponse{Imported: 2},
+	},
+}
+
+func TestImportExchangeRatesUC(t *testing.T) {
+	for _, tt := range ImportRatesCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.ExchangeRateRepository)
+			uc := &ImportExchangeRatesUC{Rates: repo}
+
+			if tt.SaveInput != nil {
+				repo.On("Save", mock.Anything, tt.SaveInput).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(),
+				dto.ImportExchangeRates{Data: strings.NewReader(tt.Input)})
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+)
+
+// cleanCategory trims a category name; a blank one means no category.
+func cleanCategory(category *string) *string {
+	if category == nil {
+		return nil
+	}
+
+	c := strings.TrimSpace(*category)
+	if c == "" {
+		return nil
+	}
+
+	return &c
+}
+
+// cleanTags trims tags and drops the ones repeating another in any case.
+func cleanTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	cleaned := make([]string, 0, len(tags))
+
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			return nil, uc_errors.ErrEmptyTag
+		}
+		if seen[strings.ToLower(t)] {
+			continue
+		}
+		seen[strings.ToLower(t)] = true
+		cleaned = append(cleaned, t)
+	}
+
+	return cleaned, nil
+}
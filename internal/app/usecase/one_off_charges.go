@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// chargedSubscription loads the subscription a one-off charge is linked to.
+func chargedSubscription(ctx context.Context, subs port.SubscriptionRepository, id int) (*entity.Subscription, error) {
+	sub, err := subs.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return nil, uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+	return sub, nil
+}
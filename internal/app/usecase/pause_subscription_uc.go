@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type PauseSubscriptionUC struct {
+	Subscriptions port.SubscriptionRepository
+	Charges       port.ChargeRepository
+	Log           *slog.Logger
+}
+
+func (uc *PauseSubscriptionUC) Execute(ctx context.Context, in dto.PauseSubscription) (dto.PauseSubscriptionResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.PauseSubscriptionResponse{Paused: false}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	day, err := parseDayOrToday(in.Date)
+	if err != nil {
+		return dto.PauseSubscriptionResponse{Paused: false}, uc_errors.ErrInvalidDate
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	sub, err := uc.Subscriptions.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.PauseSubscriptionResponse{Paused: false},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.PauseSubscriptionResponse{Paused: false},
+			uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	if sub.PausedAt != nil {
+		return dto.PauseSubscriptionResponse{Paused: false}, uc_errors.ErrSubscriptionPaused
+	}
+	if day.Before(sub.StartDate) || (sub.EndDate != nil && day.After(*sub.EndDate)) {
+		return dto.PauseSubscriptionResponse{Paused: false}, uc_errors.ErrInvalidPauseDate
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	err = uc.Subscriptions.Pause(ctx, entity.Pause{
+		SubscriptionID: sub.ID,
+		PausedAt:       day,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.PauseSubscriptionResponse{Paused: false}, uc_errors.ErrPauseOverlap
+		}
+		return dto.PauseSubscriptionResponse{Paused: false},
+			uc_errors.Wrap(uc_errors.ErrPauseSubscription, err)
+	}
+
+	syncCharges(ctx, uc.Log, uc.Charges, sub.ID)
+
+	return dto.PauseSubscriptionResponse{Paused: true}, nil
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type PauseCase struct {
+	Name          string
+	Input         dto.PauseSubscription
+	GetRepoOutput *entity.Subscription
+	RepoInput     *entity.Pause
+	Output        dto.PauseSubscriptionResponse
+	WantErr       error
+	GetRepoErr    error
+	RepoErr       error
+}
+
+var PauseCases = []PauseCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.PauseSubscription{ID: 0},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "invalid date",
+		Input:   dto.PauseSubscription{ID: 1, Date: vPtr("2026-06-01")},
+		WantErr: uc_errors.ErrInvalidDate,
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.PauseSubscription{ID: 1, Date: vPtr("01-06-2026")},
+		WantErr:    uc_errors.ErrSubscriptionNotFound,
+		GetRepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:       "get error",
+		Input:      dto.PauseSubscription{ID: 1, Date: vPtr("01-06-2026")},
+		WantErr:    uc_errors.ErrGetSubscription,
+		GetRepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "already paused",
+		Input: dto.PauseSubscription{ID: 1, Date: vPtr("01-06-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+			PausedAt:  vPtr(parseTime("01-05-2026")),
+		},
+		WantErr: uc_errors.ErrSubscriptionPaused,
+	},
+
+	{
+		Name:  "date before start",
+		Input: dto.PauseSubscription{ID: 1, Date: vPtr("01-12-2025")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+		},
+		WantErr: uc_errors.ErrInvalidPauseDate,
+	},
+
+	{
+		Name:  "date after end",
+		Input: dto.PauseSubscription{ID: 1, Date: vPtr("01-06-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+			EndDate:   vPtr(parseTime("01-03-2026")),
+		},
+		WantErr: uc_errors.ErrInvalidPauseDate,
+	},
+
+	{
+		Name:  "repository error",
+		Input: dto.PauseSubscription{ID: 1, Date: vPtr("01-06-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+		},
+		WantErr: uc_errors.ErrPauseSubscription,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "inside earlier pause",
+		Input: dto.PauseSubscription{ID: 1, Date: vPtr("01-03-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+		},
+		WantErr: uc_errors.ErrPauseOverlap,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:  "success pause",
+		Input: dto.PauseSubscription{ID: 1, Date: vPtr("01-06-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+		},
+		RepoInput: &entity.Pause{
+			SubscriptionID: 1,
+			PausedAt:       parseTime("01-06-2026"),
+		},
+		Output: dto.PauseSubscriptionResponse{Paused: true},
+	},
+}
+
+func TestPauseSubscriptionUC(t *testing.T) {
+	for _, tt := range PauseCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			uc := &PauseSubscriptionUC{Subscriptions: repo, Charges: charges}
+
+			shouldCallGet :=
+				tt.GetRepoOutput != nil ||
+					tt.GetRepoErr != nil
+
+			shouldCallPause :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrPauseSubscription) ||
+					errors.Is(tt.WantErr, uc_errors.ErrPauseOverlap)
+
+			if shouldCallGet {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.GetRepoOutput, tt.GetRepoErr)
+			}
+			if shouldCallPause {
+				var p any = mock.Anything
+				if tt.RepoInput != nil {
+					p = *tt.RepoInput
+				}
+				repo.On("Pause", mock.Anything, p).
+					Return(tt.RepoErr)
+			}
+
+			if tt.WantErr == nil {
+				charges.On("Sync", mock.Anything, tt.Input.ID, ledgerHorizon()).
+					Return(nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type RemoveMemberUC struct {
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *RemoveMemberUC) Execute(ctx context.Context, in dto.RemoveMember) (dto.RemoveMemberResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.RemoveMemberResponse{Removed: false}, uc_errors.ErrInvalidSubscriptionID
+	}
+	if in.UserID == "" {
+		return dto.RemoveMemberResponse{Removed: false}, uc_errors.ErrEmptyUserID
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	uid, err := uuid.Parse(in.UserID)
+	if err != nil {
+		return dto.RemoveMemberResponse{Removed: false}, uc_errors.ErrInvalidUserID
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.Subscriptions.RemoveMember(ctx, in.ID, uid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.RemoveMemberResponse{Removed: false},
+				uc_errors.Wrap(uc_errors.ErrMemberNotFound, err)
+		}
+		return dto.RemoveMemberResponse{Removed: false},
+			uc_errors.Wrap(uc_errors.ErrRemoveMember, err)
+	}
+
+	return dto.RemoveMemberResponse{Removed: true}, nil
+}
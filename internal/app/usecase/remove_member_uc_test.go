@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type RemoveMemberCase struct {
+	Name    string
+	Input   dto.RemoveMember
+	Output  dto.RemoveMemberResponse
+	WantErr error
+	RepoErr error
+}
+
+var RemoveMemberCases = []RemoveMemberCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.RemoveMember{ID: -1, UserID: memberUserID.String()},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "empty user id",
+		Input:   dto.RemoveMember{ID: 1},
+		WantErr: uc_errors.ErrEmptyUserID,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.RemoveMember{ID: 1, UserID: "bad"},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "not a member",
+		Input:   dto.RemoveMember{ID: 1, UserID: memberUserID.String()},
+		WantErr: uc_errors.ErrMemberNotFound,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.RemoveMember{ID: 1, UserID: memberUserID.String()},
+		WantErr: uc_errors.ErrRemoveMember,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:   "success remove",
+		Input:  dto.RemoveMember{ID: 1, UserID: memberUserID.String()},
+		Output: dto.RemoveMemberResponse{Removed: true},
+	},
+}
+
+func TestRemoveMemberUC(t *testing.T) {
+	for _, tt := range RemoveMemberCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			uc := &RemoveMemberUC{Subscriptions: repo}
+
+			shouldCallRepo :=
+				tt.WantErr == nil ||
+					errors.Is(tt.WantErr, uc_errors.ErrMemberNotFound) ||
+					errors.Is(tt.WantErr, uc_errors.ErrRemoveMember)
+
+			if shouldCallRepo {
+				repo.On("RemoveMember", mock.Anything, tt.Input.ID, memberUserID).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
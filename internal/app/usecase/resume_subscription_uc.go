@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type ResumeSubscriptionUC struct {
+	Subscriptions port.SubscriptionRepository
+	Charges       port.ChargeRepository
+	Log           *slog.Logger
+}
+
+func (uc *ResumeSubscriptionUC) Execute(ctx context.Context, in dto.ResumeSubscription) (dto.ResumeSubscriptionResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.ResumeSubscriptionResponse{Resumed: false}, uc_errors.ErrInvalidSubscriptionID
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	day, err := parseDayOrToday(in.Date)
+	if err != nil {
+		return dto.ResumeSubscriptionResponse{Resumed: false}, uc_errors.ErrInvalidDate
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	sub, err := uc.Subscriptions.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.ResumeSubscriptionResponse{Resumed: false},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.ResumeSubscriptionResponse{Resumed: false},
+			uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	if sub.PausedAt == nil {
+		return dto.ResumeSubscriptionResponse{Resumed: false}, uc_errors.ErrSubscriptionNotPaused
+	}
+	if day.Before(*sub.PausedAt) {
+		return dto.ResumeSubscriptionResponse{Resumed: false}, uc_errors.ErrInvalidResumeDate
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.Subscriptions.Resume(ctx, sub.ID, day); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.ResumeSubscriptionResponse{Resumed: false}, uc_errors.ErrSubscriptionNotPaused
+		}
+		return dto.ResumeSubscriptionResponse{Resumed: false},
+			uc_errors.Wrap(uc_errors.ErrResumeSubscription, err)
+	}
+
+	syncCharges(ctx, uc.Log, uc.Charges, sub.ID)
+
+	return dto.ResumeSubscriptionResponse{Resumed: true}, nil
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ResumeCase struct {
+	Name          string
+	Input         dto.ResumeSubscription
+	GetRepoOutput *entity.Subscription
+	Output        dto.ResumeSubscriptionResponse
+	WantErr       error
+	GetRepoErr    error
+	RepoErr       error
+}
+
+var ResumeCases = []ResumeCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.ResumeSubscription{ID: -1},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "invalid date",
+		Input:   dto.ResumeSubscription{ID: 1, Date: vPtr("32-01-2026")},
+		WantErr: uc_errors.ErrInvalidDate,
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.ResumeSubscription{ID: 1, Date: vPtr("01-08-2026")},
+		WantErr:    uc_errors.ErrSubscriptionNotFound,
+		GetRepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:  "not paused",
+		Input: dto.ResumeSubscription{ID: 1, Date: vPtr("01-08-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+		},
+		WantErr: uc_errors.ErrSubscriptionNotPaused,
+	},
+
+	{
+		Name:  "date before pause",
+		Input: dto.ResumeSubscription{ID: 1, Date: vPtr("01-05-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+			PausedAt:  vPtr(parseTime("01-06-2026")),
+		},
+		WantErr: uc_errors.ErrInvalidResumeDate,
+	},
+
+	{
+		Name:  "pause closed meanwhile",
+		Input: dto.ResumeSubscription{ID: 1, Date: vPtr("01-08-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+			PausedAt:  vPtr(parseTime("01-06-2026")),
+		},
+		WantErr: uc_errors.ErrSubscriptionNotPaused,
+		RepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:  "repository error",
+		Input: dto.ResumeSubscription{ID: 1, Date: vPtr("01-08-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+			PausedAt:  vPtr(parseTime("01-06-2026")),
+		},
+		WantErr: uc_errors.ErrResumeSubscription,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name:  "success resume",
+		Input: dto.ResumeSubscription{ID: 1, Date: vPtr("01-08-2026")},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			StartDate: parseTime("01-01-2026"),
+			PausedAt:  vPtr(parseTime("01-06-2026")),
+		},
+		Output: dto.ResumeSubscriptionResponse{Resumed: true},
+	},
+}
+
+func TestResumeSubscriptionUC(t *testing.T) {
+	for _, tt := range ResumeCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			uc := &ResumeSubscriptionUC{Subscriptions: repo, Charges: charges}
+
+			shouldCallGet :=
+				tt.GetRepoOutput != nil ||
+					tt.GetRepoErr != nil
+
+			shouldCallResume :=
+				tt.WantErr == nil ||
+					tt.RepoErr != nil
+
+			if shouldCallGet {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.GetRepoOutput, tt.GetRepoErr)
+			}
+			if shouldCallResume {
+				repo.On("Resume", mock.Anything, tt.Input.ID, parseTime(*tt.Input.Date)).
+					Return(tt.RepoErr)
+			}
+
+			if tt.WantErr == nil {
+				charges.On("Sync", mock.Anything, tt.Input.ID, ledgerHorizon()).
+					Return(nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+		})
+	}
+}
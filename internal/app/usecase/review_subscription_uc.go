@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+// ReviewSubscriptionUC approves or rejects a subscription pending approval.
+// Once approved, it is charged and counts against budgets like any other.
+type ReviewSubscriptionUC struct {
+	Subscriptions port.SubscriptionRepository
+	Services      port.ServiceRepository
+	Charges       port.ChargeRepository
+	Budgets       port.BudgetRepository
+	Rates         port.ExchangeRateRepository
+	Notifier      port.BudgetNotifier
+	Log           *slog.Logger
+}
+
+func (uc *ReviewSubscriptionUC) Execute(ctx context.Context, in dto.ReviewSubscription) (dto.ReviewSubscriptionResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.ReviewSubscriptionResponse{}, uc_errors.ErrInvalidSubscriptionID
+	}
+	if in.ApproverID == "" {
+		return dto.ReviewSubscriptionResponse{}, uc_errors.ErrEmptyApproverID
+	}
+
+	var comment *string
+	if in.Comment != nil {
+		if c := strings.TrimSpace(*in.Comment); c != "" {
+			comment = &c
+		}
+	}
+	if !in.Approve && comment == nil {
+		return dto.ReviewSubscriptionResponse{}, uc_errors.ErrEmptyRejectionComment
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	approver, err := uuid.Parse(in.ApproverID)
+	if err != nil || approver == uuid.Nil {
+		return dto.ReviewSubscriptionResponse{}, uc_errors.ErrInvalidApproverID
+	}
+
+	decision := entity.ApprovalRejected
+	if in.Approve {
+		decision = entity.ApprovalApproved
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	sub, err := uc.Subscriptions.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.ReviewSubscriptionResponse{},
+				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+		}
+		return dto.ReviewSubscriptionResponse{},
+			uc_errors.Wrap(uc_errors.ErrGetSubscription, err)
+	}
+
+	if sub.Approval != entity.ApprovalPending {
+		return dto.ReviewSubscriptionResponse{}, uc_errors.ErrSubscriptionNotPending
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	d := &entity.ApprovalDecision{
+		SubscriptionID: sub.ID,
+		Decision:       decision,
+		DecidedBy:      approver,
+		Comment:        comment,
+	}
+	if err := uc.Subscriptions.Decide(ctx, d); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.ReviewSubscriptionResponse{}, uc_errors.ErrSubscriptionNotPending
+		}
+		return dto.ReviewSubscriptionResponse{},
+			uc_errors.Wrap(uc_errors.ErrReviewSubscription, err)
+	}
+
+	resp := dto.ReviewSubscriptionResponse{
+		Decision:  string(d.Decision),
+		DecidedAt: d.DecidedAt.UTC().Format(time.RFC3339),
+	}
+
+	if decision == entity.ApprovalApproved {
+		sub.Approval = entity.ApprovalApproved
+
+		syncCharges(ctx, uc.Log, uc.Charges, sub.ID)
+		resp.Warnings = checkBudgets(ctx, uc.Log, uc.Budgets, uc.Services, uc.Subscriptions, uc.Rates, uc.Notifier, sub)
+	}
+
+	return resp, nil
+}
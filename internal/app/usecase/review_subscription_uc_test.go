@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var approverID = uuid.MustParse("0b6f1c2e-6a59-4d4e-9a44-5c1f0e7a3b21")
+
+type ReviewSubscriptionCase struct {
+	Name          string
+	Input         dto.ReviewSubscription
+	GetRepoOutput *entity.Subscription
+	RepoInput     *entity.ApprovalDecision
+	Output        dto.ReviewSubscriptionResponse
+	WantErr       error
+	GetRepoErr    error
+	RepoErr       error
+	SyncErr       error
+}
+
+var ReviewSubscriptionCases = []ReviewSubscriptionCase{
+	{
+		Name:    "invalid sub id",
+		Input:   dto.ReviewSubscription{ID: 0, Approve: true, ApproverID: approverID.String()},
+		WantErr: uc_errors.ErrInvalidSubscriptionID,
+	},
+
+	{
+		Name:    "empty approver",
+		Input:   dto.ReviewSubscription{ID: 1, Approve: true},
+		WantErr: uc_errors.ErrEmptyApproverID,
+	},
+
+	{
+		Name:    "invalid approver",
+		Input:   dto.ReviewSubscription{ID: 1, Approve: true, ApproverID: "procurement"},
+		WantErr: uc_errors.ErrInvalidApproverID,
+	},
+
+	{
+		Name:    "rejection without comment",
+		Input:   dto.ReviewSubscription{ID: 1, ApproverID: approverID.String(), Comment: vPtr("  ")},
+		WantErr: uc_errors.ErrEmptyRejectionComment,
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.ReviewSubscription{ID: 1, Approve: true, ApproverID: approverID.String()},
+		WantErr:    uc_errors.ErrSubscriptionNotFound,
+		GetRepoErr: sql.ErrNoRows,
+	},
+
+	{
+		Name:          "already approved",
+		Input:         dto.ReviewSubscription{ID: 1, Approve: true, ApproverID: approverID.String()},
+		GetRepoOutput: &entity.Subscription{ID: 1, Approval: entity.ApprovalApproved},
+		WantErr:       uc_errors.ErrSubscriptionNotPending,
+	},
+
+	{
+		Name:          "decided meanwhile",
+		Input:         dto.ReviewSubscription{ID: 1, Approve: true, ApproverID: approverID.String()},
+		GetRepoOutput: &entity.Subscription{ID: 1, Approval: entity.ApprovalPending},
+		WantErr:       uc_errors.ErrSubscriptionNotPending,
+		RepoErr:       sql.ErrNoRows,
+	},
+
+	{
+		Name:          "repository error",
+		Input:         dto.ReviewSubscription{ID: 1, Approve: true, ApproverID: approverID.String()},
+		GetRepoOutput: &entity.Subscription{ID: 1, Approval: entity.ApprovalPending},
+		WantErr:       uc_errors.ErrReviewSubscription,
+		RepoErr:       errors.New("db error"),
+	},
+
+	{
+		Name:          "charges ledger error keeps the write",
+		Input:         dto.ReviewSubscription{ID: 1, Approve: true, ApproverID: approverID.String()},
+		GetRepoOutput: &entity.Subscription{ID: 1, Approval: entity.ApprovalPending},
+		SyncErr:       errors.New("db error"),
+		Output:        dto.ReviewSubscriptionResponse{Decision: "approved", DecidedAt: "2025-03-01T12:00:00Z"},
+	},
+
+	{
+		Name:          "success approve",
+		Input:         dto.ReviewSubscription{ID: 1, Approve: true, ApproverID: approverID.String()},
+		GetRepoOutput: &entity.Subscription{ID: 1, Approval: entity.ApprovalPending},
+		RepoInput: &entity.ApprovalDecision{
+			SubscriptionID: 1,
+			Decision:       entity.ApprovalApproved,
+			DecidedBy:      approverID,
+		},
+		Output: dto.ReviewSubscriptionResponse{Decision: "approved", DecidedAt: "2025-03-01T12:00:00Z"},
+	},
+
+	{
+		Name: "success reject",
+		Input: dto.ReviewSubscription{
+			ID:         1,
+			ApproverID: approverID.String(),
+			Comment:    vPtr(" We already pay for Miro "),
+		},
+		GetRepoOutput: &entity.Subscription{ID: 1, Approval: entity.ApprovalPending},
+		RepoInput: &entity.ApprovalDecision{
+			SubscriptionID: 1,
+			Decision:       entity.ApprovalRejected,
+			DecidedBy:      approverID,
+			Comment:        vPtr("We already pay for Miro"),
+		},
+		Output: dto.ReviewSubscriptionResponse{Decision: "rejected", DecidedAt: "2025-03-01T12:00:00Z"},
+	},
+}
+
+func TestReviewSubscriptionUC(t *testing.T) {
+	decidedAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range ReviewSubscriptionCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			budgets := new(mocks.BudgetRepository)
+			uc := &ReviewSubscriptionUC{
+				Subscriptions: repo,
+				Charges:       charges,
+				Budgets:       budgets,
+				Log:           testLog,
+			}
+
+			shouldCallGet :=
+				tt.GetRepoOutput != nil ||
+					tt.GetRepoErr != nil
+
+			shouldDecide :=
+				tt.GetRepoOutput != nil &&
+					tt.GetRepoOutput.Approval == entity.ApprovalPending
+
+			shouldSync :=
+				shouldDecide &&
+					tt.RepoErr == nil &&
+					tt.Input.Approve
+
+			if shouldCallGet {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.GetRepoOutput, tt.GetRepoErr)
+			}
+			if shouldDecide {
+				var d any = mock.Anything
+				if tt.RepoInput != nil {
+					d = tt.RepoInput
+				}
+				repo.On("Decide", mock.Anything, d).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*entity.ApprovalDecision).DecidedAt = decidedAt
+					}).
+					Return(tt.RepoErr)
+			}
+			if shouldSync {
+				charges.On("Sync", mock.Anything, tt.Input.ID, ledgerHorizon()).
+					Return(tt.SyncErr)
+			}
+			if shouldSync {
+				budgets.On("GetList", mock.Anything, mock.Anything).
+					Return([]entity.Budget(nil), nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+			budgets.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// resolveService finds the catalog entry a subscription is filed under: the
+// one with the given id, or else the one known by the name, which joins the
+// catalog when there is none yet.
+func resolveService(ctx context.Context, services port.ServiceRepository, id *int, name string) (*entity.Service, error) {
+	if id != nil {
+		s, err := services.Get(ctx, *id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, uc_errors.Wrap(uc_errors.ErrServiceNotFound, err)
+			}
+			return nil, uc_errors.Wrap(uc_errors.ErrGetService, err)
+		}
+		return s, nil
+	}
+
+	name = strings.TrimSpace(name)
+
+	s, err := services.Resolve(ctx, name)
+	if err == nil {
+		return s, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, uc_errors.Wrap(uc_errors.ErrGetService, err)
+	}
+
+	s = &entity.Service{Name: name}
+	s.ID, err = services.Create(ctx, s)
+	if err != nil {
+		return nil, uc_errors.Wrap(uc_errors.ErrCreateService, err)
+	}
+
+	return s, nil
+}
+
+// cleanServiceNames trims a catalog entry's name and aliases and drops aliases
+// repeating the name or one another in any case.
+func cleanServiceNames(name string, aliases []string) (string, []string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", nil, uc_errors.ErrEmptyServiceName
+	}
+
+	seen := map[string]bool{strings.ToLower(name): true}
+	cleaned := make([]string, 0, len(aliases))
+
+	for _, a := range aliases {
+		a = strings.TrimSpace(a)
+		if a == "" {
+			return "", nil, uc_errors.ErrEmptyServiceAlias
+		}
+		if seen[strings.ToLower(a)] {
+			continue
+		}
+		seen[strings.ToLower(a)] = true
+		cleaned = append(cleaned, a)
+	}
+
+	return name, cleaned, nil
+}
+
+// checkServiceNames makes sure that none of the names is known by a catalog
+// entry other than the one with the given id.
+func checkServiceNames(ctx context.Context, services port.ServiceRepository, id int, names ...string) error {
+	for _, n := range names {
+		s, err := services.Resolve(ctx, n)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return uc_errors.Wrap(uc_errors.ErrGetService, err)
+		}
+		if s.ID != id {
+			return uc_errors.ErrServiceNameTaken
+		}
+	}
+
+	return nil
+}
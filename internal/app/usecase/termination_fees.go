@@ -0,0 +1,26 @@
+package usecase
+
+import "github.com/maket12/SubTrack/internal/domain/entity"
+
+// terminationFee is the fee for ending the contract of sub on its end date,
+// charged to its owner on that day. It is nil when sub runs on or its
+// contract is not cut short.
+func terminationFee(policy entity.TerminationFeePolicy, sub *entity.Subscription) *entity.OneOffCharge {
+	if sub.EndDate == nil {
+		return nil
+	}
+
+	amount, ok := policy.Fee(sub, *sub.EndDate)
+	if !ok {
+		return nil
+	}
+
+	return &entity.OneOffCharge{
+		SubscriptionID: &sub.ID,
+		UserID:         sub.UserID,
+		Date:           *sub.EndDate,
+		Description:    "Early termination fee: " + sub.ServiceName,
+		TerminationFee: true,
+		Money:          amount,
+	}
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type UpdateBudgetUC struct {
+	Budgets port.BudgetRepository
+}
+
+func (uc *UpdateBudgetUC) Execute(ctx context.Context, in dto.UpdateBudget) (dto.UpdateBudgetResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.UpdateBudgetResponse{Updated: false}, uc_errors.ErrInvalidBudgetID
+	}
+
+	if in.UserID == nil &&
+		in.ServiceName == nil &&
+		in.Category == nil &&
+		in.MonthlyLimit == nil &&
+		in.Currency == nil {
+		return dto.UpdateBudgetResponse{Updated: false}, nil
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	b, err := uc.Budgets.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateBudgetResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrBudgetNotFound, err)
+		}
+		return dto.UpdateBudgetResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrGetBudget, err)
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	if in.UserID != nil {
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			return dto.UpdateBudgetResponse{Updated: false}, uc_errors.ErrInvalidUserID
+		}
+		b.UserID = uid
+	}
+
+	if in.ServiceName != nil {
+		if *in.ServiceName == "" {
+			// empty string service name as "every service"
+			b.ServiceName = nil
+		} else {
+			s := *in.ServiceName
+			b.ServiceName = &s
+		}
+	}
+
+	if in.Category != nil {
+		// empty string category as "every category"
+		b.Category = cleanCategory(in.Category)
+	}
+
+	if in.MonthlyLimit != nil {
+		if *in.MonthlyLimit < 0 {
+			return dto.UpdateBudgetResponse{Updated: false}, uc_errors.ErrInvalidMonthlyLimit
+		}
+		b.MonthlyLimit = *in.MonthlyLimit
+	}
+
+	if in.Currency != nil {
+		c, ok := entity.ParseCurrency(*in.Currency)
+		if !ok {
+			return dto.UpdateBudgetResponse{Updated: false}, uc_errors.ErrInvalidCurrency
+		}
+		b.Currency = c
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.Budgets.Update(ctx, b); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateBudgetResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrBudgetNotFound, err)
+		}
+		return dto.UpdateBudgetResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrUpdateBudget, err)
+	}
+
+	return dto.UpdateBudgetResponse{Updated: true}, nil
+}
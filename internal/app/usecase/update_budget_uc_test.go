@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type UpdateBudgetCase struct {
+	Name          string
+	Input         dto.UpdateBudget
+	GetRepoErr    error
+	WantBudget    *entity.Budget
+	UpdateRepoErr error
+	Output        dto.UpdateBudgetResponse
+	WantErr       error
+}
+
+func currentBudget() *entity.Budget {
+	return &entity.Budget{
+		ID:           1,
+		UserID:       budgetUserID,
+		ServiceName:  vPtr("Netflix"),
+		MonthlyLimit: 1000,
+		Currency:     "RUB",
+	}
+}
+
+var UpdateBudgetCases = []UpdateBudgetCase{
+	{
+		Name:    "invalid budget id",
+		Input:   dto.UpdateBudget{ID: 0, MonthlyLimit: vPtr(10)},
+		WantErr: uc_errors.ErrInvalidBudgetID,
+	},
+
+	{
+		Name:   "nothing to update",
+		Input:  dto.UpdateBudget{ID: 1},
+		Output: dto.UpdateBudgetResponse{Updated: false},
+	},
+
+	{
+		Name:       "not found",
+		Input:      dto.UpdateBudget{ID: 1, MonthlyLimit: vPtr(10)},
+		GetRepoErr: sql.ErrNoRows,
+		WantErr:    uc_errors.ErrBudgetNotFound,
+	},
+
+	{
+		Name:       "get error",
+		Input:      dto.UpdateBudget{ID: 1, MonthlyLimit: vPtr(10)},
+		GetRepoErr: errors.New("db error"),
+		WantErr:    uc_errors.ErrGetBudget,
+	},
+
+	{
+		Name:    "invalid user id",
+		Input:   dto.UpdateBudget{ID: 1, UserID: vPtr("not-a-uuid")},
+		WantErr: uc_errors.ErrInvalidUserID,
+	},
+
+	{
+		Name:    "negative limit",
+		Input:   dto.UpdateBudget{ID: 1, MonthlyLimit: vPtr(-5)},
+		WantErr: uc_errors.ErrInvalidMonthlyLimit,
+	},
+
+	{
+		Name:    "invalid currency",
+		Input:   dto.UpdateBudget{ID: 1, Currency: vPtr("XXX")},
+		WantErr: uc_errors.ErrInvalidCurrency,
+	},
+
+	{
+		Name:          "update error",
+		Input:         dto.UpdateBudget{ID: 1, MonthlyLimit: vPtr(10)},
+		UpdateRepoErr: errors.New("db error"),
+		WantErr:       uc_errors.ErrUpdateBudget,
+	},
+
+	{
+		Name:  "success clear service",
+		Input: dto.UpdateBudget{ID: 1, ServiceName: vPtr(""), MonthlyLimit: vPtr(5000), Currency: vPtr("EUR")},
+		WantBudget: &entity.Budget{
+			ID:           1,
+			UserID:       budgetUserID,
+			MonthlyLimit: 5000,
+			Currency:     "EUR",
+		},
+		Output: dto.UpdateBudgetResponse{Updated: true},
+	},
+
+	{
+		Name:  "success set category",
+		Input: dto.UpdateBudget{ID: 1, Category: vPtr(" cloud ")},
+		WantBudget: &entity.Budget{
+			ID:           1,
+			UserID:       budgetUserID,
+			ServiceName:  vPtr("Netflix"),
+			Category:     vPtr("cloud"),
+			MonthlyLimit: 1000,
+			Currency:     "RUB",
+		},
+		Output: dto.UpdateBudgetResponse{Updated: true},
+	},
+}
+
+func TestUpdateBudgetUC(t *testing.T) {
+	for _, tt := range UpdateBudgetCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.BudgetRepository)
+			uc := &UpdateBudgetUC{Budgets: repo}
+
+			hasChanges :=
+				tt.Input.UserID != nil ||
+					tt.Input.ServiceName != nil ||
+					tt.Input.Category != nil ||
+					tt.Input.MonthlyLimit != nil ||
+					tt.Input.Currency != nil
+
+			needGet := tt.Input.ID > 0 && hasChanges
+			if needGet {
+				var b *entity.Budget
+				if tt.GetRepoErr == nil {
+					b = currentBudget()
+				}
+				repo.On("Get", mock.Anything, tt.Input.ID).Return(b, tt.GetRepoErr)
+			}
+
+			needUpdate :=
+				tt.WantErr == nil && needGet ||
+					errors.Is(tt.WantErr, uc_errors.ErrUpdateBudget)
+
+			if needUpdate {
+				var want any = mock.AnythingOfType("*entity.Budget")
+				if tt.WantBudget != nil {
+					want = tt.WantBudget
+				}
+				repo.On("Update", mock.Anything, want).Return(tt.UpdateRepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
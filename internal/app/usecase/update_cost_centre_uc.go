@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type UpdateCostCentreUC struct {
+	CostCentres port.CostCentreRepository
+}
+
+func (uc *UpdateCostCentreUC) Execute(ctx context.Context, in dto.UpdateCostCentre) (dto.UpdateCostCentreResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.UpdateCostCentreResponse{Updated: false}, uc_errors.ErrInvalidCostCentreID
+	}
+
+	if in.Name == nil && in.Code == nil {
+		return dto.UpdateCostCentreResponse{Updated: false}, nil
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	c, err := uc.CostCentres.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateCostCentreResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrCostCentreNotFound, err)
+		}
+		return dto.UpdateCostCentreResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrGetCostCentre, err)
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	name := c.Name
+	if in.Name != nil {
+		name = *in.Name
+	}
+
+	code := c.Code
+	if in.Code != nil {
+		code = in.Code
+	}
+
+	name, code, err = cleanCostCentre(name, code)
+	if err != nil {
+		return dto.UpdateCostCentreResponse{Updated: false}, err
+	}
+
+	if err := checkCostCentreName(ctx, uc.CostCentres, c.ID, name); err != nil {
+		return dto.UpdateCostCentreResponse{Updated: false}, err
+	}
+
+	c.Name = name
+	c.Code = code
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.CostCentres.Update(ctx, c); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateCostCentreResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrCostCentreNotFound, err)
+		}
+		return dto.UpdateCostCentreResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrUpdateCostCentre, err)
+	}
+
+	return dto.UpdateCostCentreResponse{Updated: true}, nil
+}
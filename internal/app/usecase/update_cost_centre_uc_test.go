@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type UpdateCostCentreCase struct {
+	Name       string
+	Input      dto.UpdateCostCentre
+	Current    *entity.CostCentre
+	GetErr     error
+	Taken      *entity.CostCentre
+	WantCentre *entity.CostCentre
+	Output     dto.UpdateCostCentreResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var UpdateCostCentreCases = []UpdateCostCentreCase{
+	{
+		Name:    "invalid cost centre id",
+		Input:   dto.UpdateCostCentre{ID: 0, Name: vPtr("Marketing")},
+		WantErr: uc_errors.ErrInvalidCostCentreID,
+	},
+
+	{
+		Name:   "nothing to update",
+		Input:  dto.UpdateCostCentre{ID: 1},
+		Output: dto.UpdateCostCentreResponse{Updated: false},
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.UpdateCostCentre{ID: 1, Name: vPtr("Marketing")},
+		GetErr:  sql.ErrNoRows,
+		WantErr: uc_errors.ErrCostCentreNotFound,
+	},
+
+	{
+		Name:    "empty name",
+		Input:   dto.UpdateCostCentre{ID: 1, Name: vPtr(" ")},
+		Current: &entity.CostCentre{ID: 1, Name: "Marketing"},
+		WantErr: uc_errors.ErrEmptyCostCentreName,
+	},
+
+	{
+		Name:    "name taken",
+		Input:   dto.UpdateCostCentre{ID: 1, Name: vPtr("Sales")},
+		Current: &entity.CostCentre{ID: 1, Name: "Marketing"},
+		Taken:   &entity.CostCentre{ID: 2, Name: "Sales"},
+		WantErr: uc_errors.ErrCostCentreNameTaken,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.UpdateCostCentre{ID: 1, Code: vPtr("CC-1")},
+		Current:    &entity.CostCentre{ID: 1, Name: "Marketing"},
+		WantCentre: &entity.CostCentre{ID: 1, Name: "Marketing", Code: vPtr("CC-1")},
+		WantErr:    uc_errors.ErrUpdateCostCentre,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success rename keeps code",
+		Input:      dto.UpdateCostCentre{ID: 1, Name: vPtr("marketing")},
+		Current:    &entity.CostCentre{ID: 1, Name: "Marketing", Code: vPtr("CC-1")},
+		Taken:      &entity.CostCentre{ID: 1, Name: "Marketing"},
+		WantCentre: &entity.CostCentre{ID: 1, Name: "marketing", Code: vPtr("CC-1")},
+		Output:     dto.UpdateCostCentreResponse{Updated: true},
+	},
+
+	{
+		Name:       "success clears code",
+		Input:      dto.UpdateCostCentre{ID: 1, Code: vPtr("")},
+		Current:    &entity.CostCentre{ID: 1, Name: "Marketing", Code: vPtr("CC-1")},
+		WantCentre: &entity.CostCentre{ID: 1, Name: "Marketing"},
+		Output:     dto.UpdateCostCentreResponse{Updated: true},
+	},
+}
+
+func TestUpdateCostCentreUC(t *testing.T) {
+	for _, tt := range UpdateCostCentreCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.CostCentreRepository)
+			uc := &UpdateCostCentreUC{CostCentres: repo}
+
+			if tt.Current != nil || tt.GetErr != nil {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.Current, tt.GetErr)
+			}
+
+			repo.On("FindByName", mock.Anything, mock.Anything).
+				Return(func(context.Context, string) (*entity.CostCentre, error) {
+					if tt.Taken != nil {
+						return tt.Taken, nil
+					}
+					return nil, sql.ErrNoRows
+				}).Maybe()
+
+			if tt.WantCentre != nil {
+				repo.On("Update", mock.Anything, tt.WantCentre).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
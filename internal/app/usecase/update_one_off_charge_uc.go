@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+
+	"github.com/google/uuid"
+)
+
+type UpdateOneOffChargeUC struct {
+	Charges       port.OneOffChargeRepository
+	Subscriptions port.SubscriptionRepository
+}
+
+func (uc *UpdateOneOffChargeUC) Execute(ctx context.Context, in dto.UpdateOneOffCharge) (dto.UpdateOneOffChargeResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.UpdateOneOffChargeResponse{Updated: false}, uc_errors.ErrInvalidOneOffChargeID
+	}
+
+	if in.SubscriptionID == nil &&
+		in.UserID == nil &&
+		in.Date == nil &&
+		in.Amount == nil &&
+		in.Currency == nil &&
+		in.Description == nil {
+		return dto.UpdateOneOffChargeResponse{Updated: false}, nil
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	c, err := uc.Charges.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateOneOffChargeResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrOneOffChargeNotFound, err)
+		}
+		return dto.UpdateOneOffChargeResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrGetOneOffCharge, err)
+	}
+
+	// a termination fee is kept in step with its contract by cancellations
+	// and contract changes
+	if c.TerminationFee {
+		return dto.UpdateOneOffChargeResponse{Updated: false}, uc_errors.ErrTerminationFeeCharge
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	// every problem with the input is collected, not just the first one
+	errs := &entity.ValidationError{}
+
+	// the subscription is looked up once the rest is known to be valid
+	if in.SubscriptionID != nil {
+		switch {
+		case *in.SubscriptionID == 0:
+			c.SubscriptionID = nil
+		case *in.SubscriptionID < 0:
+			errs.Add("subscription_id", entity.CodeInvalid, uc_errors.ErrInvalidSubscriptionID)
+		default:
+			c.SubscriptionID = in.SubscriptionID
+		}
+	}
+
+	if in.UserID != nil {
+		if *in.UserID == "" {
+			errs.Add("user_id", entity.CodeRequired, uc_errors.ErrEmptyUserID)
+		}
+		uid, err := uuid.Parse(*in.UserID)
+		if err != nil || uid == uuid.Nil {
+			errs.Add("user_id", entity.CodeInvalid, uc_errors.ErrInvalidUserID)
+		}
+		c.UserID = uid
+	}
+
+	if in.Date != nil {
+		t, err := time.Parse("02-01-2006", *in.Date)
+		if err != nil {
+			errs.Add("date", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+		} else {
+			c.Date = t
+		}
+	}
+
+	if in.Amount != nil {
+		c.Amount = *in.Amount
+	}
+
+	if in.Currency != nil {
+		// an unknown code is reported by the charge itself
+		c.Currency, _ = entity.ParseCurrency(*in.Currency)
+	}
+
+	if in.Description != nil {
+		c.Description = strings.TrimSpace(*in.Description)
+	}
+
+	errs.Merge(c.Validate())
+
+	if err := errs.Err(); err != nil {
+		return dto.UpdateOneOffChargeResponse{Updated: false}, err
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if in.SubscriptionID != nil && c.SubscriptionID != nil {
+		if _, err := chargedSubscription(ctx, uc.Subscriptions, *c.SubscriptionID); err != nil {
+			return dto.UpdateOneOffChargeResponse{Updated: false}, err
+		}
+	}
+
+	if err := uc.Charges.Update(ctx, c); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateOneOffChargeResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrOneOffChargeNotFound, err)
+		}
+		return dto.UpdateOneOffChargeResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrUpdateOneOffCharge, err)
+	}
+
+	return dto.UpdateOneOffChargeResponse{Updated: true}, nil
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type UpdateOneOffChargeCase struct {
+	Name       string
+	Input      dto.UpdateOneOffCharge
+	Current    *entity.OneOffCharge
+	GetErr     error
+	GetSubErr  error
+	WantCharge *entity.OneOffCharge
+	Output     dto.UpdateOneOffChargeResponse
+	WantErr    error
+	RepoErr    error
+}
+
+// currentOneOff is a charge of subscription 7 as stored before the update.
+func currentOneOff() *entity.OneOffCharge {
+	return &entity.OneOffCharge{
+		ID:             1,
+		SubscriptionID: vPtr(7),
+		UserID:         oneOffOwner,
+		Date:           parseTime("01-02-2025"),
+		Description:    "Setup fee",
+		Money:          entity.Money{Amount: 2000, Currency: "EUR"},
+	}
+}
+
+var UpdateOneOffChargeCases = []UpdateOneOffChargeCase{
+	{
+		Name:    "invalid charge id",
+		Input:   dto.UpdateOneOffCharge{ID: 0, Amount: vPtr(100)},
+		WantErr: uc_errors.ErrInvalidOneOffChargeID,
+	},
+
+	{
+		Name:   "nothing to update",
+		Input:  dto.UpdateOneOffCharge{ID: 1},
+		Output: dto.UpdateOneOffChargeResponse{Updated: false},
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.UpdateOneOffCharge{ID: 1, Amount: vPtr(100)},
+		GetErr:  sql.ErrNoRows,
+		WantErr: uc_errors.ErrOneOffChargeNotFound,
+	},
+
+	{
+		Name:  "termination fee",
+		Input: dto.UpdateOneOffCharge{ID: 1, SubscriptionID: vPtr(0)},
+		Current: func() *entity.OneOffCharge {
+			c := currentOneOff()
+			c.TerminationFee = true
+			return c
+		}(),
+		WantErr: uc_errors.ErrTerminationFeeCharge,
+	},
+
+	{
+		Name:    "negative amount",
+		Input:   dto.UpdateOneOffCharge{ID: 1, Amount: vPtr(-5)},
+		Current: currentOneOff(),
+		WantErr: entity.ErrNegativeAmount,
+	},
+
+	{
+		Name:    "invalid date",
+		Input:   dto.UpdateOneOffCharge{ID: 1, Date: vPtr("2025-02-01")},
+		Current: currentOneOff(),
+		WantErr: uc_errors.ErrInvalidDate,
+	},
+
+	{
+		Name:      "subscription not found",
+		Input:     dto.UpdateOneOffCharge{ID: 1, SubscriptionID: vPtr(9)},
+		Current:   currentOneOff(),
+		GetSubErr: sql.ErrNoRows,
+		WantErr:   uc_errors.ErrSubscriptionNotFound,
+	},
+
+	{
+		Name:    "repository error",
+		Input:   dto.UpdateOneOffCharge{ID: 1, Amount: vPtr(2500)},
+		Current: currentOneOff(),
+		WantCharge: &entity.OneOffCharge{
+			ID:             1,
+			SubscriptionID: vPtr(7),
+			UserID:         oneOffOwner,
+			Date:           parseTime("01-02-2025"),
+			Description:    "Setup fee",
+			Money:          entity.Money{Amount: 2500, Currency: "EUR"},
+		},
+		WantErr: uc_errors.ErrUpdateOneOffCharge,
+		RepoErr: errors.New("db error"),
+	},
+
+	{
+		Name: "success unlinks subscription",
+		Input: dto.UpdateOneOffCharge{
+			ID:             1,
+			SubscriptionID: vPtr(0),
+			Date:           vPtr("15-02-2025"),
+			Description:    vPtr(" Onboarding workshop "),
+		},
+		Current: currentOneOff(),
+		WantCharge: &entity.OneOffCharge{
+			ID:          1,
+			UserID:      oneOffOwner,
+			Date:        parseTime("15-02-2025"),
+			Description: "Onboarding workshop",
+			Money:       entity.Money{Amount: 2000, Currency: "EUR"},
+		},
+		Output: dto.UpdateOneOffChargeResponse{Updated: true},
+	},
+
+	{
+		Name:    "success moves to another subscription",
+		Input:   dto.UpdateOneOffCharge{ID: 1, SubscriptionID: vPtr(9), Currency: vPtr("usd")},
+		Current: currentOneOff(),
+		WantCharge: &entity.OneOffCharge{
+			ID:             1,
+			SubscriptionID: vPtr(9),
+			UserID:         oneOffOwner,
+			Date:           parseTime("01-02-2025"),
+			Description:    "Setup fee",
+			Money:          entity.Money{Amount: 2000, Currency: "USD"},
+		},
+		Output: dto.UpdateOneOffChargeResponse{Updated: true},
+	},
+}
+
+func TestUpdateOneOffChargeUC(t *testing.T) {
+	for _, tt := range UpdateOneOffChargeCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.OneOffChargeRepository)
+			subs := new(mocks.SubscriptionRepository)
+			uc := &UpdateOneOffChargeUC{Charges: repo, Subscriptions: subs}
+
+			if tt.Current != nil || tt.GetErr != nil {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.Current, tt.GetErr)
+			}
+
+			if tt.Input.SubscriptionID != nil && *tt.Input.SubscriptionID > 0 {
+				subs.On("Get", mock.Anything, *tt.Input.SubscriptionID).
+					Return(&entity.Subscription{ID: *tt.Input.SubscriptionID}, tt.GetSubErr)
+			}
+
+			if tt.WantCharge != nil {
+				repo.On("Update", mock.Anything, tt.WantCharge).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+			subs.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type UpdateServiceUC struct {
+	Services port.ServiceRepository
+}
+
+func (uc *UpdateServiceUC) Execute(ctx context.Context, in dto.UpdateService) (dto.UpdateServiceResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.UpdateServiceResponse{Updated: false}, uc_errors.ErrInvalidServiceID
+	}
+
+	if in.Name == nil && in.Aliases == nil {
+		return dto.UpdateServiceResponse{Updated: false}, nil
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	s, err := uc.Services.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateServiceResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrServiceNotFound, err)
+		}
+		return dto.UpdateServiceResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrGetService, err)
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	name := s.Name
+	if in.Name != nil {
+		name = *in.Name
+	}
+
+	aliases := s.Aliases
+	if in.Aliases != nil {
+		aliases = *in.Aliases
+	}
+
+	name, aliases, err = cleanServiceNames(name, aliases)
+	if err != nil {
+		return dto.UpdateServiceResponse{Updated: false}, err
+	}
+
+	if err := checkServiceNames(ctx, uc.Services, s.ID, append([]string{name}, aliases...)...); err != nil {
+		return dto.UpdateServiceResponse{Updated: false}, err
+	}
+
+	s.Name = name
+	s.Aliases = aliases
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.Services.Update(ctx, s); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateServiceResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrServiceNotFound, err)
+		}
+		return dto.UpdateServiceResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrUpdateService, err)
+	}
+
+	return dto.UpdateServiceResponse{Updated: true}, nil
+}
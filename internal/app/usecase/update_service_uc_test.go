@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type UpdateServiceCase struct {
+	Name        string
+	Input       dto.UpdateService
+	Current     *entity.Service
+	GetErr      error
+	Taken       map[string]*entity.Service
+	WantService *entity.Service
+	Output      dto.UpdateServiceResponse
+	WantErr     error
+	RepoErr     error
+}
+
+var UpdateServiceCases = []UpdateServiceCase{
+	{
+		Name:    "invalid service id",
+		Input:   dto.UpdateService{ID: 0, Name: vPtr("Netflix")},
+		WantErr: uc_errors.ErrInvalidServiceID,
+	},
+
+	{
+		Name:   "nothing to update",
+		Input:  dto.UpdateService{ID: 1},
+		Output: dto.UpdateServiceResponse{Updated: false},
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.UpdateService{ID: 1, Name: vPtr("Netflix")},
+		GetErr:  sql.ErrNoRows,
+		WantErr: uc_errors.ErrServiceNotFound,
+	},
+
+	{
+		Name:    "empty name",
+		Input:   dto.UpdateService{ID: 1, Name: vPtr("")},
+		Current: &entity.Service{ID: 1, Name: "Netflix"},
+		WantErr: uc_errors.ErrEmptyServiceName,
+	},
+
+	{
+		Name:    "name taken",
+		Input:   dto.UpdateService{ID: 1, Name: vPtr("Spotify")},
+		Current: &entity.Service{ID: 1, Name: "Netflix"},
+		Taken:   map[string]*entity.Service{"Spotify": {ID: 2, Name: "Spotify"}},
+		WantErr: uc_errors.ErrServiceNameTaken,
+	},
+
+	{
+		Name:        "repository error",
+		Input:       dto.UpdateService{ID: 1, Aliases: &[]string{}},
+		Current:     &entity.Service{ID: 1, Name: "Netflix", Aliases: []string{"NFLX"}},
+		WantService: &entity.Service{ID: 1, Name: "Netflix", Aliases: []string{}},
+		WantErr:     uc_errors.ErrUpdateService,
+		RepoErr:     errors.New("db error"),
+	},
+
+	{
+		Name:        "success rename keeps aliases",
+		Input:       dto.UpdateService{ID: 1, Name: vPtr("netflix")},
+		Current:     &entity.Service{ID: 1, Name: "Netflix", Aliases: []string{"NFLX"}},
+		Taken:       map[string]*entity.Service{"netflix": {ID: 1, Name: "Netflix"}},
+		WantService: &entity.Service{ID: 1, Name: "netflix", Aliases: []string{"NFLX"}},
+		Output:      dto.UpdateServiceResponse{Updated: true},
+	},
+}
+
+func TestUpdateServiceUC(t *testing.T) {
+	for _, tt := range UpdateServiceCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.ServiceRepository)
+			uc := &UpdateServiceUC{Services: repo}
+
+			if tt.Current != nil || tt.GetErr != nil {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.Current, tt.GetErr)
+			}
+
+			repo.On("Resolve", mock.Anything, mock.Anything).
+				Return(func(_ context.Context, name string) (*entity.Service, error) {
+					if s, ok := tt.Taken[name]; ok {
+						return s, nil
+					}
+					return nil, sql.ErrNoRows
+				}).Maybe()
+
+			if tt.WantService != nil {
+				repo.On("Update", mock.Anything, tt.WantService).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/mappers"
 	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
 	"github.com/maket12/SubTrack/internal/domain/port"
 
 	"github.com/google/uuid"
@@ -15,6 +19,15 @@ import (
 
 type UpdateSubscriptionUC struct {
 	Subscriptions port.SubscriptionRepository
+	Services      port.ServiceRepository
+	Vendors       port.VendorRepository
+	Charges       port.ChargeRepository
+	Budgets       port.BudgetRepository
+	Rates         port.ExchangeRateRepository
+	Notifier      port.BudgetNotifier
+	Log           *slog.Logger
+
+	TerminationFees entity.TerminationFeePolicy
 }
 
 func (uc *UpdateSubscriptionUC) Execute(ctx context.Context, in dto.UpdateSubscription) (dto.UpdateSubscriptionResponse, error) {
@@ -26,11 +39,22 @@ func (uc *UpdateSubscriptionUC) Execute(ctx context.Context, in dto.UpdateSubscr
 		return dto.UpdateSubscriptionResponse{Updated: false}, uc_errors.ErrInvalidSubscriptionID
 	}
 
-	if in.ServiceName == nil &&
+	if in.ServiceID == nil &&
+		in.ServiceName == nil &&
+		in.Category == nil &&
+		in.Tags == nil &&
 		in.Price == nil &&
+		in.Currency == nil &&
+		in.BillingPeriod == nil &&
 		in.UserID == nil &&
 		in.StartDate == nil &&
-		in.EndDate == nil {
+		in.EndDate == nil &&
+		in.TrialEndDate == nil &&
+		in.TrialPrice == nil &&
+		in.VendorID == nil &&
+		in.MinimumTermMonths == nil &&
+		in.NoticePeriodDays == nil &&
+		in.RenewalOwnerID == nil {
 		return dto.UpdateSubscriptionResponse{Updated: false}, nil
 	}
 
@@ -52,24 +76,64 @@ func (uc *UpdateSubscriptionUC) Execute(ctx context.Context, in dto.UpdateSubscr
 	   #	 Parsing      #
 	   ####################
 	*/
-	if in.ServiceName != nil {
-		if *in.ServiceName == "" {
-			return dto.UpdateSubscriptionResponse{Updated: false}, uc_errors.ErrEmptyServiceName
+	// every problem with the input is collected, not just the first one
+	errs := &entity.ValidationError{}
+
+	// only a contract with a minimum term, now or before, can have a fee
+	hadTerm := sub.MinimumTermMonths != nil
+
+	// the service is looked up in the catalog once the rest is known to be valid
+	if in.ServiceID != nil && *in.ServiceID <= 0 {
+		errs.Add("service_id", entity.CodeInvalid, uc_errors.ErrInvalidServiceID)
+	}
+	if in.ServiceID == nil && in.ServiceName != nil && strings.TrimSpace(*in.ServiceName) == "" {
+		errs.Add("service_name", entity.CodeRequired, uc_errors.ErrEmptyServiceName)
+	}
+
+	if in.Category != nil {
+		// a blank category as "no category"
+		sub.Category = cleanCategory(in.Category)
+	}
+
+	if in.Tags != nil {
+		tags, err := cleanTags(*in.Tags)
+		if err != nil {
+			errs.Add("tags", entity.CodeInvalid, err)
+		}
+		sub.Tags = tags
+	}
+
+	// A new price never overwrites the old one: it opens a new price period,
+	// so that past months keep being charged at the price in effect then.
+	var newPrice *entity.PricePeriod
+	if in.Price != nil && *in.Price != sub.Price {
+		effective, err := parseDayOrToday(in.PriceEffectiveFrom)
+		if err != nil {
+			errs.Add("price_effective_from", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+		}
+		newPrice = &entity.PricePeriod{
+			SubscriptionID: sub.ID,
+			Price:          *in.Price,
+			EffectiveFrom:  effective,
 		}
-		sub.ServiceName = *in.ServiceName
 	}
 
-	if in.Price != nil {
-		sub.Price = *in.Price
+	if in.Currency != nil {
+		// an unknown code is reported by the subscription itself
+		sub.Currency, _ = entity.ParseCurrency(*in.Currency)
+	}
+
+	if in.BillingPeriod != nil {
+		sub.BillingPeriod = entity.BillingPeriod(*in.BillingPeriod)
 	}
 
 	if in.UserID != nil {
 		if *in.UserID == "" {
-			return dto.UpdateSubscriptionResponse{Updated: false}, uc_errors.ErrEmptyUserID
+			errs.Add("user_id", entity.CodeRequired, uc_errors.ErrEmptyUserID)
 		}
 		uid, err := uuid.Parse(*in.UserID)
 		if err != nil || uid == uuid.Nil {
-			return dto.UpdateSubscriptionResponse{Updated: false}, uc_errors.ErrInvalidUserID
+			errs.Add("user_id", entity.CodeInvalid, uc_errors.ErrInvalidUserID)
 		}
 		sub.UserID = uid
 	}
@@ -77,9 +141,10 @@ func (uc *UpdateSubscriptionUC) Execute(ctx context.Context, in dto.UpdateSubscr
 	if in.StartDate != nil {
 		t, err := time.Parse("02-01-2006", *in.StartDate)
 		if err != nil {
-			return dto.UpdateSubscriptionResponse{Updated: false}, uc_errors.ErrInvalidDate
+			errs.Add("start_date", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+		} else {
+			sub.StartDate = t
 		}
-		sub.StartDate = t
 	}
 
 	if in.EndDate != nil {
@@ -89,17 +154,133 @@ func (uc *UpdateSubscriptionUC) Execute(ctx context.Context, in dto.UpdateSubscr
 		} else {
 			t, err := time.Parse("02-01-2006", *in.EndDate)
 			if err != nil {
-				return dto.UpdateSubscriptionResponse{Updated: false}, uc_errors.ErrInvalidDate
+				errs.Add("end_date", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+			} else {
+				sub.EndDate = &t
+			}
+		}
+	}
+
+	if in.TrialEndDate != nil {
+		if *in.TrialEndDate == "" {
+			// empty string trial end-date as "no trial"
+			sub.TrialEndDate = nil
+		} else {
+			t, err := time.Parse("02-01-2006", *in.TrialEndDate)
+			if err != nil {
+				errs.Add("trial_end_date", entity.CodeInvalid, uc_errors.ErrInvalidDate)
+			} else {
+				sub.TrialEndDate = &t
+			}
+		}
+	}
+
+	if in.TrialPrice != nil {
+		sub.TrialPrice = *in.TrialPrice
+	}
+
+	// the vendor is looked up once the rest is known to be valid
+	if in.VendorID != nil {
+		switch {
+		case *in.VendorID == 0:
+			sub.VendorID = nil
+		case *in.VendorID < 0:
+			errs.Add("vendor_id", entity.CodeInvalid, uc_errors.ErrInvalidVendorID)
+		default:
+			sub.VendorID = in.VendorID
+		}
+	}
+
+	if in.MinimumTermMonths != nil {
+		sub.MinimumTermMonths = in.MinimumTermMonths
+		if *in.MinimumTermMonths == 0 {
+			sub.MinimumTermMonths = nil
+		}
+	}
+
+	if in.NoticePeriodDays != nil {
+		sub.NoticePeriodDays = in.NoticePeriodDays
+		if *in.NoticePeriodDays == 0 {
+			sub.NoticePeriodDays = nil
+		}
+	}
+
+	if in.RenewalOwnerID != nil {
+		if *in.RenewalOwnerID == "" {
+			sub.RenewalOwner = nil
+		} else {
+			owner, err := uuid.Parse(*in.RenewalOwnerID)
+			if err != nil || owner == uuid.Nil {
+				errs.Add("renewal_owner_id", entity.CodeInvalid, uc_errors.ErrInvalidRenewalOwnerID)
+			} else {
+				sub.RenewalOwner = &owner
 			}
-			sub.EndDate = &t
 		}
 	}
 
+	// the new price is stored as a price period, but holds the same
+	// invariants as the one on the subscription
+	checked := *sub
+	if newPrice != nil {
+		checked.Price = newPrice.Price
+	}
+	errs.Merge(checked.Validate())
+
+	if err := errs.Err(); err != nil {
+		return dto.UpdateSubscriptionResponse{Updated: false}, err
+	}
+
 	/* ####################
 	   #	 Request      #
 	   ####################
 	*/
-	if err := uc.Subscriptions.Update(ctx, sub); err != nil {
+	if in.VendorID != nil && sub.VendorID != nil {
+		if err := checkVendor(ctx, uc.Vendors, *sub.VendorID); err != nil {
+			return dto.UpdateSubscriptionResponse{Updated: false}, err
+		}
+	}
+
+	if in.ServiceID != nil || in.ServiceName != nil {
+		var name string
+		if in.ServiceName != nil {
+			name = *in.ServiceName
+		}
+
+		service, err := resolveService(ctx, uc.Services, in.ServiceID, name)
+		if err != nil {
+			return dto.UpdateSubscriptionResponse{Updated: false}, err
+		}
+		sub.ServiceID = &service.ID
+		sub.ServiceName = service.Name
+		// the fee is described by the service the subscription ends up under
+		checked.ServiceID = sub.ServiceID
+		checked.ServiceName = sub.ServiceName
+	}
+
+	// Ending a contract within its minimum term costs a fee, which is only
+	// charged once the caller has seen it and confirmed. It is priced when the
+	// contract changes and kept as agreed otherwise.
+	contractChanged := (hadTerm || sub.MinimumTermMonths != nil) &&
+		(in.StartDate != nil || in.EndDate != nil || in.MinimumTermMonths != nil)
+	var fee *entity.OneOffCharge
+	if contractChanged {
+		fee = terminationFee(uc.TerminationFees, &checked)
+		if fee != nil && !in.Confirm {
+			return dto.UpdateSubscriptionResponse{
+				Updated:              false,
+				TerminationFee:       mappers.MapIntoTerminationFeeDTO(fee),
+				ConfirmationRequired: true,
+			}, nil
+		}
+	}
+
+	// the fee is recorded together with the contract change
+	if contractChanged {
+		err = uc.Subscriptions.UpdateContract(ctx, sub, fee)
+	} else {
+		err = uc.Subscriptions.Update(ctx, sub)
+	}
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return dto.UpdateSubscriptionResponse{Updated: false},
 				uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
@@ -108,5 +289,24 @@ func (uc *UpdateSubscriptionUC) Execute(ctx context.Context, in dto.UpdateSubscr
 			uc_errors.Wrap(uc_errors.ErrUpdateSubscription, err)
 	}
 
-	return dto.UpdateSubscriptionResponse{Updated: true}, nil
+	if newPrice != nil {
+		if err := uc.Subscriptions.ChangePrice(ctx, *newPrice); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return dto.UpdateSubscriptionResponse{Updated: false},
+					uc_errors.Wrap(uc_errors.ErrSubscriptionNotFound, err)
+			}
+			return dto.UpdateSubscriptionResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrUpdateSubscription, err)
+		}
+	}
+
+	syncCharges(ctx, uc.Log, uc.Charges, sub.ID)
+
+	warnings := checkBudgets(ctx, uc.Log, uc.Budgets, uc.Services, uc.Subscriptions, uc.Rates, uc.Notifier, sub)
+
+	return dto.UpdateSubscriptionResponse{
+		Updated:        true,
+		Warnings:       warnings,
+		TerminationFee: mappers.MapIntoTerminationFeeDTO(fee),
+	}, nil
 }
@@ -24,6 +24,11 @@ type UpdateSubscriptionCase struct {
 	WantErr       error
 	GetRepoErr    error
 	UpdateRepoErr error
+	// WantPrice is the price period expected to be appended, when the
+	// case pins it down exactly.
+	WantPrice      *entity.PricePeriod
+	ChangePriceErr error
+	SyncErr        error
 }
 
 var UpdateSubscriptionCases = []UpdateSubscriptionCase{
@@ -161,6 +166,34 @@ var UpdateSubscriptionCases = []UpdateSubscriptionCase{
 		WantErr: uc_errors.ErrInvalidDate,
 	},
 
+	{
+		Name: "invalid currency",
+		Input: dto.UpdateSubscription{
+			ID:          1,
+			ServiceName: nil,
+			Price:       vPtr(15),
+			Currency:    vPtr("EURO"),
+			UserID:      nil,
+			StartDate:   nil,
+			EndDate:     nil,
+		},
+		WantErr: entity.ErrUnknownCurrency,
+	},
+
+	{
+		Name: "invalid billing period",
+		Input: dto.UpdateSubscription{
+			ID:            1,
+			ServiceName:   nil,
+			Price:         vPtr(12000),
+			BillingPeriod: vPtr("daily"),
+			UserID:        nil,
+			StartDate:     nil,
+			EndDate:       nil,
+		},
+		WantErr: entity.ErrUnknownPeriod,
+	},
+
 	{
 		Name: "not found(update)",
 		Input: dto.UpdateSubscription{
@@ -252,28 +285,224 @@ var UpdateSubscriptionCases = []UpdateSubscriptionCase{
 		GetRepoErr:    nil,
 		UpdateRepoErr: nil,
 	},
+
+	{
+		Name: "empty tag",
+		Input: dto.UpdateSubscription{
+			ID:   1,
+			Tags: &[]string{"team-design", " "},
+		},
+		WantErr: uc_errors.ErrEmptyTag,
+	},
+
+	{
+		Name: "success set category and tags",
+		Input: dto.UpdateSubscription{
+			ID:       1,
+			Category: vPtr(" cloud "),
+			Tags:     &[]string{"team-design", "Team-Design", "prod"},
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:          1,
+			ServiceName: "AWS",
+			Price:       500,
+		},
+		Output: dto.UpdateSubscriptionResponse{Updated: true},
+	},
+
+	{
+		Name: "invalid price effective date",
+		Input: dto.UpdateSubscription{
+			ID:                 1,
+			Price:              vPtr(600),
+			PriceEffectiveFrom: vPtr("2025/01/01"),
+		},
+		WantErr: uc_errors.ErrInvalidDate,
+	},
+
+	{
+		Name: "repository error(change price)",
+		Input: dto.UpdateSubscription{
+			ID:    1,
+			Price: vPtr(600),
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:    1,
+			Price: 500,
+		},
+		WantErr:        uc_errors.ErrUpdateSubscription,
+		ChangePriceErr: errors.New("db error"),
+	},
+
+	{
+		Name: "success price change from date",
+		Input: dto.UpdateSubscription{
+			ID:                 1,
+			Price:              vPtr(600),
+			PriceEffectiveFrom: vPtr("01-03-2025"),
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:    1,
+			Price: 500,
+		},
+		WantPrice: &entity.PricePeriod{
+			SubscriptionID: 1,
+			Price:          600,
+			EffectiveFrom:  parseTime("01-03-2025"),
+		},
+		Output: dto.UpdateSubscriptionResponse{Updated: true},
+	},
+
+	{
+		Name: "same price is not a new period",
+		Input: dto.UpdateSubscription{
+			ID:    1,
+			Price: vPtr(500),
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:    1,
+			Price: 500,
+		},
+		Output: dto.UpdateSubscriptionResponse{Updated: true},
+	},
+
+	{
+		Name: "trial ends before start",
+		Input: dto.UpdateSubscription{
+			ID:           1,
+			TrialEndDate: vPtr("01-12-2024"),
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			Price:     500,
+			StartDate: parseTime("01-01-2025"),
+		},
+		WantErr: entity.ErrTrialBeforeStart,
+	},
+
+	{
+		Name: "negative price",
+		Input: dto.UpdateSubscription{
+			ID:    1,
+			Price: vPtr(-100),
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			Price:     500,
+			StartDate: parseTime("01-01-2025"),
+		},
+		WantErr: entity.ErrNegativePrice,
+	},
+
+	{
+		Name: "end before start",
+		Input: dto.UpdateSubscription{
+			ID:      1,
+			EndDate: vPtr("31-12-2024"),
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			Price:     500,
+			StartDate: parseTime("01-01-2025"),
+		},
+		WantErr: entity.ErrEndBeforeStart,
+	},
+
+	{
+		Name: "success clear trial",
+		Input: dto.UpdateSubscription{
+			ID:           1,
+			TrialEndDate: vPtr(""),
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:           1,
+			Price:        500,
+			StartDate:    parseTime("01-01-2025"),
+			TrialEndDate: vPtr(parseTime("31-01-2025")),
+		},
+		Output: dto.UpdateSubscriptionResponse{Updated: true},
+	},
+
+	{
+		Name: "charges ledger error keeps the write",
+		Input: dto.UpdateSubscription{
+			ID:      1,
+			EndDate: vPtr("31-12-2025"),
+		},
+		GetRepoOutput: &entity.Subscription{
+			ID:        1,
+			Price:     500,
+			StartDate: parseTime("01-01-2025"),
+		},
+		Output:  dto.UpdateSubscriptionResponse{Updated: true},
+		SyncErr: errors.New("db error"),
+	},
 }
 
 func allNilInput(in dto.UpdateSubscription) bool {
-	return in.ServiceName == nil &&
+	return in.ServiceID == nil &&
+		in.ServiceName == nil &&
+		in.Category == nil &&
+		in.Tags == nil &&
 		in.Price == nil &&
+		in.Currency == nil &&
+		in.BillingPeriod == nil &&
 		in.UserID == nil &&
 		in.StartDate == nil &&
-		in.EndDate == nil
+		in.EndDate == nil &&
+		in.TrialEndDate == nil &&
+		in.TrialPrice == nil &&
+		in.VendorID == nil &&
+		in.MinimumTermMonths == nil &&
+		in.NoticePeriodDays == nil &&
+		in.RenewalOwnerID == nil
 }
 
 func isValidationAfterGet(err error) bool {
 	return errors.Is(err, uc_errors.ErrEmptyServiceName) ||
 		errors.Is(err, uc_errors.ErrEmptyUserID) ||
 		errors.Is(err, uc_errors.ErrInvalidUserID) ||
-		errors.Is(err, uc_errors.ErrInvalidDate)
+		errors.Is(err, uc_errors.ErrInvalidDate) ||
+		errors.Is(err, entity.ErrUnknownPeriod) ||
+		errors.Is(err, entity.ErrUnknownCurrency) ||
+		errors.Is(err, entity.ErrTrialBeforeStart) ||
+		errors.Is(err, entity.ErrEndBeforeStart) ||
+		errors.Is(err, entity.ErrNegativePrice) ||
+		errors.Is(err, uc_errors.ErrEmptyTag)
+}
+
+// stored completes a fixture with the fields every subscription read back
+// from the database has, so that only the update under test can break it.
+func stored(s entity.Subscription) *entity.Subscription {
+	if s.UserID == uuid.Nil {
+		s.UserID = uuid.MustParse("3f1c2a4e-8d7b-4c5a-9e6f-1a2b3c4d5e6f")
+	}
+	if s.Currency == "" {
+		s.Currency = entity.DefaultCurrency
+	}
+	if s.BillingPeriod == "" {
+		s.BillingPeriod = entity.BillingPeriodMonthly
+	}
+	if s.Seats == 0 {
+		s.Seats = 1
+	}
+	return &s
 }
 
 func TestUpdateSubscriptionUC(t *testing.T) {
 	for _, tt := range UpdateSubscriptionCases {
 		t.Run(tt.Name, func(t *testing.T) {
 			repo := new(mocks.SubscriptionRepository)
-			uc := &UpdateSubscriptionUC{Subscriptions: repo}
+			charges := new(mocks.ChargeRepository)
+			services := new(mocks.ServiceRepository)
+			budgets := new(mocks.BudgetRepository)
+			uc := &UpdateSubscriptionUC{
+				Subscriptions: repo,
+				Services:      services,
+				Charges:       charges,
+				Budgets:       budgets,
+				Log:           testLog,
+			}
 
 			allNil := allNilInput(tt.Input)
 
@@ -282,9 +511,9 @@ func TestUpdateSubscriptionUC(t *testing.T) {
 			if needGet {
 				var sub *entity.Subscription
 				if tt.GetRepoOutput != nil {
-					sub = tt.GetRepoOutput
+					sub = stored(*tt.GetRepoOutput)
 				} else if tt.GetRepoErr == nil {
-					sub = &entity.Subscription{}
+					sub = stored(entity.Subscription{})
 				}
 				repo.
 					On("Get", mock.Anything, tt.Input.ID).
@@ -296,12 +525,55 @@ func TestUpdateSubscriptionUC(t *testing.T) {
 					tt.GetRepoErr == nil &&
 					!isValidationAfterGet(tt.WantErr)
 
+			if needUpdate && tt.Input.ServiceName != nil {
+				services.
+					On("Resolve", mock.Anything, *tt.Input.ServiceName).
+					Return(&entity.Service{ID: 1, Name: *tt.Input.ServiceName}, nil)
+			}
+
 			if needUpdate {
 				repo.
 					On("Update", mock.Anything, mock.AnythingOfType("*entity.Subscription")).
 					Return(tt.UpdateRepoErr)
 			}
 
+			currentPrice := 0
+			if tt.GetRepoOutput != nil {
+				currentPrice = tt.GetRepoOutput.Price
+			}
+			needChangePrice :=
+				needUpdate &&
+					tt.UpdateRepoErr == nil &&
+					tt.Input.Price != nil &&
+					*tt.Input.Price != currentPrice
+
+			if needChangePrice {
+				var want any = mock.AnythingOfType("entity.PricePeriod")
+				if tt.WantPrice != nil {
+					want = *tt.WantPrice
+				}
+				repo.
+					On("ChangePrice", mock.Anything, want).
+					Return(tt.ChangePriceErr)
+			}
+
+			needSync :=
+				needUpdate &&
+					tt.UpdateRepoErr == nil &&
+					(!needChangePrice || tt.ChangePriceErr == nil)
+
+			if needSync {
+				charges.
+					On("Sync", mock.Anything, mock.Anything, ledgerHorizon()).
+					Return(tt.SyncErr)
+			}
+
+			if needSync {
+				budgets.
+					On("GetList", mock.Anything, mock.Anything).
+					Return([]entity.Budget(nil), nil)
+			}
+
 			resp, err := uc.Execute(context.Background(), tt.Input)
 
 			if tt.WantErr != nil {
@@ -314,6 +586,285 @@ func TestUpdateSubscriptionUC(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+			services.AssertExpectations(t)
+			budgets.AssertExpectations(t)
+		})
+	}
+}
+
+type UpdateContractCase struct {
+	Name      string
+	Input     dto.UpdateSubscription
+	VendorErr error
+	WantSub   func(s *entity.Subscription) bool
+	WantErr   error
+}
+
+func TestUpdateSubscriptionUC_Contract(t *testing.T) {
+	owner := uuid.New()
+
+	cases := []UpdateContractCase{
+		{
+			Name:    "invalid vendor id",
+			Input:   dto.UpdateSubscription{ID: 1, VendorID: vPtr(-1)},
+			WantErr: uc_errors.ErrInvalidVendorID,
+		},
+
+		{
+			Name:    "invalid renewal owner id",
+			Input:   dto.UpdateSubscription{ID: 1, RenewalOwnerID: vPtr("not-a-uuid")},
+			WantErr: uc_errors.ErrInvalidRenewalOwnerID,
+		},
+
+		{
+			Name:      "vendor not found",
+			Input:     dto.UpdateSubscription{ID: 1, VendorID: vPtr(5)},
+			VendorErr: sql.ErrNoRows,
+			WantErr:   uc_errors.ErrVendorNotFound,
+		},
+
+		{
+			Name: "success sets contract",
+			Input: dto.UpdateSubscription{
+				ID:                1,
+				VendorID:          vPtr(5),
+				NoticePeriodDays:  vPtr(60),
+				RenewalOwnerID:    vPtr(owner.String()),
+				MinimumTermMonths: vPtr(24),
+			},
+			WantSub: func(s *entity.Subscription) bool {
+				return *s.VendorID == 5 &&
+					*s.MinimumTermMonths == 24 &&
+					*s.NoticePeriodDays == 60 &&
+					*s.RenewalOwner == owner
+			},
+		},
+
+		{
+			Name: "success clears contract",
+			Input: dto.UpdateSubscription{
+				ID:                1,
+				VendorID:          vPtr(0),
+				NoticePeriodDays:  vPtr(0),
+				RenewalOwnerID:    vPtr(""),
+				MinimumTermMonths: vPtr(0),
+			},
+			WantSub: func(s *entity.Subscription) bool {
+				return s.VendorID == nil &&
+					s.MinimumTermMonths == nil &&
+					s.NoticePeriodDays == nil &&
+					s.RenewalOwner == nil
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			vendors := new(mocks.VendorRepository)
+			budgets := new(mocks.BudgetRepository)
+			uc := &UpdateSubscriptionUC{
+				Subscriptions: repo,
+				Vendors:       vendors,
+				Charges:       charges,
+				Budgets:       budgets,
+			}
+
+			repo.On("Get", mock.Anything, 1).
+				Return(stored(entity.Subscription{
+					ID:                1,
+					VendorID:          vPtr(2),
+					MinimumTermMonths: vPtr(12),
+					NoticePeriodDays:  vPtr(30),
+					RenewalOwner:      &owner,
+				}), nil)
+
+			if tt.VendorErr != nil || (tt.WantSub != nil && *tt.Input.VendorID > 0) {
+				vendors.On("Get", mock.Anything, 5).
+					Return(&entity.Vendor{ID: 5, Name: "Atlassian"}, tt.VendorErr)
+			}
+			if tt.WantSub != nil {
+				if tt.Input.MinimumTermMonths != nil {
+					// the contract runs on, so there is no termination fee
+					repo.On("UpdateContract", mock.Anything, mock.MatchedBy(tt.WantSub),
+						(*entity.OneOffCharge)(nil)).Return(nil)
+				} else {
+					repo.On("Update", mock.Anything, mock.MatchedBy(tt.WantSub)).
+						Return(nil)
+				}
+				charges.On("Sync", mock.Anything, 1, ledgerHorizon()).
+					Return(nil)
+				budgets.On("GetList", mock.Anything, mock.Anything).
+					Return([]entity.Budget(nil), nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.UpdateSubscriptionResponse{Updated: true}, resp)
+			}
+
+			repo.AssertExpectations(t)
+			vendors.AssertExpectations(t)
+		})
+	}
+}
+
+type UpdateTerminationFeeCase struct {
+	Name    string
+	Input   dto.UpdateSubscription
+	Policy  entity.TerminationFeePolicy
+	RepoErr error
+	WantFee *dto.Money
+	// WantDescription describes the recorded fee, "Early termination fee:
+	// Jira" by default.
+	WantDescription string
+	WantResp        dto.UpdateSubscriptionResponse
+	WantErr         error
+}
+
+func TestUpdateSubscriptionUC_TerminationFee(t *testing.T) {
+	remaining := entity.TerminationFeePolicy{Rule: entity.TerminationFeeRemainingMonths}
+	fee := &dto.Money{Amount: 9000, Currency: "RUB"}
+
+	cases := []UpdateTerminationFeeCase{
+		{
+			Name:    "early end needs confirmation",
+			Input:   dto.UpdateSubscription{ID: 1, EndDate: vPtr("31-03-2026")},
+			Policy:  remaining,
+			WantFee: fee,
+			WantResp: dto.UpdateSubscriptionResponse{
+				Updated:              false,
+				TerminationFee:       fee,
+				ConfirmationRequired: true,
+			},
+		},
+
+		{
+			Name:     "confirmed early end charges remaining months",
+			Input:    dto.UpdateSubscription{ID: 1, EndDate: vPtr("31-03-2026"), Confirm: true},
+			Policy:   remaining,
+			WantFee:  fee,
+			WantResp: dto.UpdateSubscriptionResponse{Updated: true, TerminationFee: fee},
+		},
+
+		{
+			Name:    "confirmed early end charges flat fee",
+			Input:   dto.UpdateSubscription{ID: 1, EndDate: vPtr("31-03-2026"), Confirm: true},
+			Policy:  entity.TerminationFeePolicy{Rule: entity.TerminationFeeFlat, FlatAmount: 2500},
+			WantFee: &dto.Money{Amount: 2500, Currency: "RUB"},
+			WantResp: dto.UpdateSubscriptionResponse{
+				Updated:        true,
+				TerminationFee: &dto.Money{Amount: 2500, Currency: "RUB"},
+			},
+		},
+
+		{
+			Name: "fee names the service the update files under",
+			Input: dto.UpdateSubscription{
+				ID:          1,
+				ServiceName: vPtr("jira software"),
+				EndDate:     vPtr("31-03-2026"),
+				Confirm:     true,
+			},
+			Policy:          remaining,
+			WantFee:         fee,
+			WantDescription: "Early termination fee: Jira Software",
+			WantResp:        dto.UpdateSubscriptionResponse{Updated: true, TerminationFee: fee},
+		},
+
+		{
+			Name:     "end at term end has no fee",
+			Input:    dto.UpdateSubscription{ID: 1, EndDate: vPtr("31-12-2026")},
+			Policy:   remaining,
+			WantResp: dto.UpdateSubscriptionResponse{Updated: true},
+		},
+
+		{
+			Name:    "repository error records no fee",
+			Input:   dto.UpdateSubscription{ID: 1, EndDate: vPtr("31-03-2026"), Confirm: true},
+			Policy:  remaining,
+			WantFee: fee,
+			RepoErr: errors.New("db error"),
+			WantErr: uc_errors.ErrUpdateSubscription,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.SubscriptionRepository)
+			charges := new(mocks.ChargeRepository)
+			budgets := new(mocks.BudgetRepository)
+			services := new(mocks.ServiceRepository)
+			uc := &UpdateSubscriptionUC{
+				Subscriptions:   repo,
+				Services:        services,
+				Charges:         charges,
+				Budgets:         budgets,
+				TerminationFees: tt.Policy,
+			}
+
+			if tt.Input.ServiceName != nil {
+				services.On("Resolve", mock.Anything, *tt.Input.ServiceName).
+					Return(&entity.Service{ID: 3, Name: "Jira Software"}, nil)
+			}
+			description := "Early termination fee: Jira"
+			if tt.WantDescription != "" {
+				description = tt.WantDescription
+			}
+
+			sub := stored(entity.Subscription{
+				ID:                1,
+				ServiceName:       "Jira",
+				Price:             1000,
+				StartDate:         parseTime("01-01-2026"),
+				MinimumTermMonths: vPtr(12),
+			})
+			repo.On("Get", mock.Anything, 1).Return(sub, nil)
+
+			preview := tt.WantFee != nil && !tt.Input.Confirm
+			if !preview {
+				repo.On("UpdateContract", mock.Anything, mock.Anything,
+					mock.MatchedBy(func(f *entity.OneOffCharge) bool {
+						if tt.WantFee == nil {
+							return f == nil
+						}
+						return f != nil &&
+							f.Amount == tt.WantFee.Amount &&
+							f.Date.Equal(parseTime("31-03-2026")) &&
+							f.UserID == sub.UserID &&
+							f.Description == description &&
+							f.TerminationFee
+					})).Return(tt.RepoErr)
+			}
+			if !preview && tt.WantErr == nil {
+				charges.On("Sync", mock.Anything, 1, ledgerHorizon()).
+					Return(nil)
+				budgets.On("GetList", mock.Anything, mock.Anything).
+					Return([]entity.Budget(nil), nil)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.WantResp, resp)
+			}
+
+			repo.AssertExpectations(t)
+			charges.AssertExpectations(t)
+			services.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+type UpdateVendorUC struct {
+	Vendors port.VendorRepository
+}
+
+func (uc *UpdateVendorUC) Execute(ctx context.Context, in dto.UpdateVendor) (dto.UpdateVendorResponse, error) {
+	/* ####################
+	   #	Validation    #
+	   ####################
+	*/
+	if in.ID <= 0 {
+		return dto.UpdateVendorResponse{Updated: false}, uc_errors.ErrInvalidVendorID
+	}
+
+	if in.Name == nil &&
+		in.Contact == nil &&
+		in.AccountManager == nil &&
+		in.Notes == nil {
+		return dto.UpdateVendorResponse{Updated: false}, nil
+	}
+
+	/* ####################
+	   #   Load current   #
+	   ####################
+	*/
+	v, err := uc.Vendors.Get(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateVendorResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrVendorNotFound, err)
+		}
+		return dto.UpdateVendorResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrGetVendor, err)
+	}
+
+	/* ####################
+	   #	 Parsing      #
+	   ####################
+	*/
+	// a blank detail clears it
+	if in.Name != nil {
+		v.Name = *in.Name
+	}
+	if in.Contact != nil {
+		v.Contact = in.Contact
+	}
+	if in.AccountManager != nil {
+		v.AccountManager = in.AccountManager
+	}
+	if in.Notes != nil {
+		v.Notes = in.Notes
+	}
+
+	if err := cleanVendor(v); err != nil {
+		return dto.UpdateVendorResponse{Updated: false}, err
+	}
+
+	if err := checkVendorName(ctx, uc.Vendors, v.ID, v.Name); err != nil {
+		return dto.UpdateVendorResponse{Updated: false}, err
+	}
+
+	/* ####################
+	   #	 Request      #
+	   ####################
+	*/
+	if err := uc.Vendors.Update(ctx, v); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateVendorResponse{Updated: false},
+				uc_errors.Wrap(uc_errors.ErrVendorNotFound, err)
+		}
+		return dto.UpdateVendorResponse{Updated: false},
+			uc_errors.Wrap(uc_errors.ErrUpdateVendor, err)
+	}
+
+	return dto.UpdateVendorResponse{Updated: true}, nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/maket12/SubTrack/internal/app/dto"
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type UpdateVendorCase struct {
+	Name       string
+	Input      dto.UpdateVendor
+	Current    *entity.Vendor
+	GetErr     error
+	Taken      *entity.Vendor
+	WantVendor *entity.Vendor
+	Output     dto.UpdateVendorResponse
+	WantErr    error
+	RepoErr    error
+}
+
+var UpdateVendorCases = []UpdateVendorCase{
+	{
+		Name:    "invalid vendor id",
+		Input:   dto.UpdateVendor{ID: 0, Name: vPtr("Atlassian")},
+		WantErr: uc_errors.ErrInvalidVendorID,
+	},
+
+	{
+		Name:   "nothing to update",
+		Input:  dto.UpdateVendor{ID: 1},
+		Output: dto.UpdateVendorResponse{Updated: false},
+	},
+
+	{
+		Name:    "not found",
+		Input:   dto.UpdateVendor{ID: 1, Name: vPtr("Atlassian")},
+		GetErr:  sql.ErrNoRows,
+		WantErr: uc_errors.ErrVendorNotFound,
+	},
+
+	{
+		Name:    "empty name",
+		Input:   dto.UpdateVendor{ID: 1, Name: vPtr(" ")},
+		Current: &entity.Vendor{ID: 1, Name: "Atlassian"},
+		WantErr: uc_errors.ErrEmptyVendorName,
+	},
+
+	{
+		Name:    "name taken",
+		Input:   dto.UpdateVendor{ID: 1, Name: vPtr("Adobe")},
+		Current: &entity.Vendor{ID: 1, Name: "Atlassian"},
+		Taken:   &entity.Vendor{ID: 2, Name: "Adobe"},
+		WantErr: uc_errors.ErrVendorNameTaken,
+	},
+
+	{
+		Name:       "repository error",
+		Input:      dto.UpdateVendor{ID: 1, AccountManager: vPtr("Jane Roe")},
+		Current:    &entity.Vendor{ID: 1, Name: "Atlassian"},
+		WantVendor: &entity.Vendor{ID: 1, Name: "Atlassian", AccountManager: vPtr("Jane Roe")},
+		WantErr:    uc_errors.ErrUpdateVendor,
+		RepoErr:    errors.New("db error"),
+	},
+
+	{
+		Name:       "success rename keeps details",
+		Input:      dto.UpdateVendor{ID: 1, Name: vPtr("atlassian")},
+		Current:    &entity.Vendor{ID: 1, Name: "Atlassian", Contact: vPtr("billing@atlassian.com")},
+		Taken:      &entity.Vendor{ID: 1, Name: "Atlassian"},
+		WantVendor: &entity.Vendor{ID: 1, Name: "atlassian", Contact: vPtr("billing@atlassian.com")},
+		Output:     dto.UpdateVendorResponse{Updated: true},
+	},
+
+	{
+		Name:       "success clears notes",
+		Input:      dto.UpdateVendor{ID: 1, Notes: vPtr("")},
+		Current:    &entity.Vendor{ID: 1, Name: "Atlassian", Notes: vPtr("old notes")},
+		WantVendor: &entity.Vendor{ID: 1, Name: "Atlassian"},
+		Output:     dto.UpdateVendorResponse{Updated: true},
+	},
+}
+
+func TestUpdateVendorUC(t *testing.T) {
+	for _, tt := range UpdateVendorCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo := new(mocks.VendorRepository)
+			uc := &UpdateVendorUC{Vendors: repo}
+
+			if tt.Current != nil || tt.GetErr != nil {
+				repo.On("Get", mock.Anything, tt.Input.ID).
+					Return(tt.Current, tt.GetErr)
+			}
+
+			repo.On("FindByName", mock.Anything, mock.Anything).
+				Return(func(context.Context, string) (*entity.Vendor, error) {
+					if tt.Taken != nil {
+						return tt.Taken, nil
+					}
+					return nil, sql.ErrNoRows
+				}).Maybe()
+
+			if tt.WantVendor != nil {
+				repo.On("Update", mock.Anything, tt.WantVendor).
+					Return(tt.RepoErr)
+			}
+
+			resp, err := uc.Execute(context.Background(), tt.Input)
+
+			if tt.WantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.WantErr),
+					"expected error '%v' but got '%v'", tt.WantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Output, resp)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/maket12/SubTrack/internal/app/uc_errors"
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/port"
+)
+
+// cleanVendor trims a vendor's name and details, dropping blank details.
+func cleanVendor(v *entity.Vendor) error {
+	v.Name = strings.TrimSpace(v.Name)
+	if v.Name == "" {
+		return uc_errors.ErrEmptyVendorName
+	}
+
+	v.Contact = cleanText(v.Contact)
+	v.AccountManager = cleanText(v.AccountManager)
+	v.Notes = cleanText(v.Notes)
+
+	return nil
+}
+
+// cleanText trims an optional text; a blank one means none.
+func cleanText(s *string) *string {
+	if s == nil {
+		return nil
+	}
+
+	t := strings.TrimSpace(*s)
+	if t == "" {
+		return nil
+	}
+
+	return &t
+}
+
+// checkVendorName makes sure that no vendor other than the one with the given
+// id goes by the name.
+func checkVendorName(ctx context.Context, vendors port.VendorRepository, id int, name string) error {
+	v, err := vendors.FindByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return uc_errors.Wrap(uc_errors.ErrGetVendor, err)
+	}
+	if v.ID != id {
+		return uc_errors.ErrVendorNameTaken
+	}
+
+	return nil
+}
+
+// checkVendor makes sure that the vendor a subscription is linked to exists.
+func checkVendor(ctx context.Context, vendors port.VendorRepository, id int) error {
+	if _, err := vendors.Get(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uc_errors.Wrap(uc_errors.ErrVendorNotFound, err)
+		}
+		return uc_errors.Wrap(uc_errors.ErrGetVendor, err)
+	}
+
+	return nil
+}
@@ -1,6 +1,11 @@
 package config
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+
 	"github.com/caarlos0/env/v11"
 )
 
@@ -9,6 +14,16 @@ type Config struct {
 	LogLevel    string `env:"LOG_LEVEL" envDefault:"INFO"`
 
 	DatabaseDSN string `env:"DATABASE_DSN,required"`
+
+	ChargesJobInterval time.Duration `env:"CHARGES_JOB_INTERVAL" envDefault:"24h"`
+
+	// RequireApproval creates new subscriptions pending approval.
+	RequireApproval bool `env:"REQUIRE_APPROVAL" envDefault:"false"`
+
+	// TerminationFeeRule prices ending a contract within its minimum term:
+	// remaining_months or flat, the latter charging TerminationFeeFlat.
+	TerminationFeeRule entity.TerminationFeeRule `env:"TERMINATION_FEE_RULE" envDefault:"remaining_months"`
+	TerminationFeeFlat int                       `env:"TERMINATION_FEE_FLAT" envDefault:"0"`
 }
 
 func Load() (*Config, error) {
@@ -16,5 +31,14 @@ func Load() (*Config, error) {
 	if err := env.Parse(cfg); err != nil {
 		return nil, err
 	}
+	if cfg.ChargesJobInterval <= 0 {
+		return nil, fmt.Errorf("CHARGES_JOB_INTERVAL must be positive")
+	}
+	if !cfg.TerminationFeeRule.Valid() {
+		return nil, fmt.Errorf("unknown TERMINATION_FEE_RULE %q", cfg.TerminationFeeRule)
+	}
+	if cfg.TerminationFeeFlat < 0 {
+		return nil, fmt.Errorf("TERMINATION_FEE_FLAT must not be negative")
+	}
 	return cfg, nil
 }
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Approval is where a subscription stands in the approval workflow. Only
+// approved subscriptions are charged, counted in totals and renewed.
+type Approval string
+
+const (
+	ApprovalPending  Approval = "pending"
+	ApprovalApproved Approval = "approved"
+	ApprovalRejected Approval = "rejected"
+)
+
+// ApprovalDecision is an approver's sign-off on, or rejection of, a pending
+// subscription.
+type ApprovalDecision struct {
+	ID             int       `db:"id"`
+	SubscriptionID int       `db:"subscription_id"`
+	Decision       Approval  `db:"decision"`
+	DecidedBy      uuid.UUID `db:"decided_by"`
+	Comment        *string   `db:"comment"`
+	DecidedAt      time.Time `db:"decided_at"`
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+type BillingPeriod string
+
+const (
+	BillingPeriodWeekly    BillingPeriod = "weekly"
+	BillingPeriodMonthly   BillingPeriod = "monthly"
+	BillingPeriodQuarterly BillingPeriod = "quarterly"
+	BillingPeriodYearly    BillingPeriod = "yearly"
+)
+
+func (p BillingPeriod) Valid() bool {
+	switch p {
+	case BillingPeriodWeekly,
+		BillingPeriodMonthly,
+		BillingPeriodQuarterly,
+		BillingPeriodYearly:
+		return true
+	}
+	return false
+}
+
+// MonthlyFactor is the share of one billing period that falls into a month.
+func (p BillingPeriod) MonthlyFactor() float64 {
+	switch p {
+	case BillingPeriodWeekly:
+		return 52.0 / 12.0
+	case BillingPeriodQuarterly:
+		return 1.0 / 3.0
+	case BillingPeriodYearly:
+		return 1.0 / 12.0
+	default:
+		return 1
+	}
+}
+
+// Monthly normalises a price charged once per period to a monthly figure.
+func (p BillingPeriod) Monthly(price int) int {
+	return int(math.Round(float64(price) * p.MonthlyFactor()))
+}
+
+// AddTo moves t forward by n billing periods. Month-based periods keep t's day
+// of month, clamped to the last day of shorter months.
+func (p BillingPeriod) AddTo(t time.Time, n int) time.Time {
+	var months int
+	switch p {
+	case BillingPeriodWeekly:
+		return t.AddDate(0, 0, 7*n)
+	case BillingPeriodQuarterly:
+		months = 3 * n
+	case BillingPeriodYearly:
+		months = 12 * n
+	default:
+		months = n
+	}
+
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), lastDay)-1)
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Budget caps what a user spends per month, on every service or on one, and
+// optionally only on the services of one category.
+type Budget struct {
+	ID           int       `db:"id"`
+	UserID       uuid.UUID `db:"user_id"`
+	ServiceName  *string   `db:"service_name"`
+	Category     *string   `db:"category"`
+	MonthlyLimit int       `db:"monthly_limit"`
+	Currency     Currency  `db:"currency"`
+}
+
+// Covers reports whether spend on the subscription counts towards the budget.
+// service is the catalog entry s is filed under, or nil; a budget may name
+// the service by any name it goes by.
+func (b Budget) Covers(s *Subscription, service *Service) bool {
+	if b.ServiceName != nil && *b.ServiceName != s.ServiceName &&
+		(service == nil || !service.KnownAs(*b.ServiceName)) {
+		return false
+	}
+	if b.Category != nil && (s.Category == nil || !strings.EqualFold(*b.Category, *s.Category)) {
+		return false
+	}
+	return true
+}
+
+// BudgetAlert tells that the spend of the month starting at Month went over
+// the budget's limit.
+type BudgetAlert struct {
+	Budget Budget
+	Month  time.Time
+	Spent  Money
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// Charge is a billing event recorded in the charges ledger: the amount a
+// subscription is billed on Date.
+type Charge struct {
+	ID             int       `db:"id"`
+	SubscriptionID int       `db:"subscription_id"`
+	Date           time.Time `db:"charge_date"`
+	Money
+}
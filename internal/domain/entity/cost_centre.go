@@ -0,0 +1,25 @@
+package entity
+
+// CostCentre is a department or team that subscription spend is charged back
+// to.
+type CostCentre struct {
+	ID   int     `db:"id"`
+	Name string  `db:"name"`
+	Code *string `db:"code"`
+}
+
+// Allocation charges Percent of a subscription's spend to a cost centre. The
+// part of the spend no allocation covers stays unallocated.
+type Allocation struct {
+	SubscriptionID int     `db:"subscription_id"`
+	CostCentreID   int     `db:"cost_centre_id"`
+	Percent        float64 `db:"percent"`
+}
+
+// ChargebackAmount is the spend charged to one cost centre in one currency.
+// The unallocated spend comes without a cost centre.
+type ChargebackAmount struct {
+	CostCentreID   *int    `db:"cost_centre_id"`
+	CostCentreName *string `db:"cost_centre_name"`
+	Money
+}
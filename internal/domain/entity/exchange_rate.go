@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// ExchangeRate states that one unit of Base was worth Rate units of Quote
+// starting from Date.
+type ExchangeRate struct {
+	Date  time.Time `db:"rate_date"`
+	Base  Currency  `db:"base"`
+	Quote Currency  `db:"quote"`
+	Rate  float64   `db:"rate"`
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "github.com/google/uuid"
+
+// Member is a user sharing a subscription paid by its owner. A member pays
+// either a percentage of the monthly price or a fixed monthly amount in the
+// subscription's currency; the owner pays the rest.
+type Member struct {
+	SubscriptionID int       `db:"subscription_id"`
+	UserID         uuid.UUID `db:"user_id"`
+	SharePercent   *float64  `db:"share_percent"`
+	ShareAmount    *int      `db:"share_amount"`
+}
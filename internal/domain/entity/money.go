@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Currency is an ISO 4217 alphabetic currency code.
+type Currency string
+
+const DefaultCurrency Currency = "RUB"
+
+func ParseCurrency(code string) (Currency, bool) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	return c, c.Valid()
+}
+
+func (c Currency) Valid() bool {
+	_, ok := iso4217[c]
+	return ok
+}
+
+type Money struct {
+	Amount   int      `db:"amount"`
+	Currency Currency `db:"currency"`
+}
+
+// MonthlyAmount is the amount charged in one currency during the calendar
+// month starting at Month.
+type MonthlyAmount struct {
+	Month time.Time `db:"month"`
+	Money
+}
+
+// ForecastAmount is the amount expected in one currency during the calendar
+// month starting at Month, either committed by fixed-term subscriptions or
+// assumed from open-ended ones.
+type ForecastAmount struct {
+	Month     time.Time `db:"month"`
+	Committed bool      `db:"committed"`
+	Money
+}
+
+// GroupedAmount is the amount charged in one currency for one group of a
+// breakdown. Keys the breakdown is not grouped by are left nil.
+type GroupedAmount struct {
+	ServiceName *string    `db:"service_name"`
+	UserID      *uuid.UUID `db:"user_id"`
+	Month       *time.Time `db:"month"`
+	Money
+}
+
+// iso4217 lists the active ISO 4217 currency codes.
+var iso4217 = map[Currency]struct{}{
+	"AED": {}, "AFN": {}, "ALL": {}, "AMD": {}, "ANG": {}, "AOA": {}, "ARS": {}, "AUD": {},
+	"AWG": {}, "AZN": {}, "BAM": {}, "BBD": {}, "BDT": {}, "BGN": {}, "BHD": {}, "BIF": {},
+	"BMD": {}, "BND": {}, "BOB": {}, "BRL": {}, "BSD": {}, "BTN": {}, "BWP": {}, "BYN": {},
+	"BZD": {}, "CAD": {}, "CDF": {}, "CHF": {}, "CLP": {}, "CNY": {}, "COP": {}, "CRC": {},
+	"CUP": {}, "CVE": {}, "CZK": {}, "DJF": {}, "DKK": {}, "DOP": {}, "DZD": {}, "EGP": {},
+	"ERN": {}, "ETB": {}, "EUR": {}, "FJD": {}, "FKP": {}, "GBP": {}, "GEL": {}, "GHS": {},
+	"GIP": {}, "GMD": {}, "GNF": {}, "GTQ": {}, "GYD": {}, "HKD": {}, "HNL": {}, "HTG": {},
+	"HUF": {}, "IDR": {}, "ILS": {}, "INR": {}, "IQD": {}, "IRR": {}, "ISK": {}, "JMD": {},
+	"JOD": {}, "JPY": {}, "KES": {}, "KGS": {}, "KHR": {}, "KMF": {}, "KPW": {}, "KRW": {},
+	"KWD": {}, "KYD": {}, "KZT": {}, "LAK": {}, "LBP": {}, "LKR": {}, "LRD": {}, "LSL": {},
+	"LYD": {}, "MAD": {}, "MDL": {}, "MGA": {}, "MKD": {}, "MMK": {}, "MNT": {}, "MOP": {},
+	"MRU": {}, "MUR": {}, "MVR": {}, "MWK": {}, "MXN": {}, "MYR": {}, "MZN": {}, "NAD": {},
+	"NGN": {}, "NIO": {}, "NOK": {}, "NPR": {}, "NZD": {}, "OMR": {}, "PAB": {}, "PEN": {},
+	"PGK": {}, "PHP": {}, "PKR": {}, "PLN": {}, "PYG": {}, "QAR": {}, "RON": {}, "RSD": {},
+	"RUB": {}, "RWF": {}, "SAR": {}, "SBD": {}, "SCR": {}, "SDG": {}, "SEK": {}, "SGD": {},
+	"SHP": {}, "SLE": {}, "SOS": {}, "SRD": {}, "SSP": {}, "STN": {}, "SVC": {}, "SYP": {},
+	"SZL": {}, "THB": {}, "TJS": {}, "TMT": {}, "TND": {}, "TOP": {}, "TRY": {}, "TTD": {},
+	"TWD": {}, "TZS": {}, "UAH": {}, "UGX": {}, "USD": {}, "UYU": {}, "UZS": {}, "VES": {},
+	"VND": {}, "VUV": {}, "WST": {}, "XAF": {}, "XCD": {}, "XOF": {}, "XPF": {}, "YER": {},
+	"ZAR": {}, "ZMW": {}, "ZWG": {},
+}
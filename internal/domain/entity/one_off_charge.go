@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNegativeAmount = errors.New("amount must not be negative")
+	ErrNoDescription  = errors.New("description must not be empty")
+)
+
+// OneOffCharge is an amount paid once on Date, such as a setup fee or an
+// overage bill, and counted in the month of that day. It belongs to a
+// subscription or, without one, to the user alone.
+type OneOffCharge struct {
+	ID             int       `db:"id"`
+	SubscriptionID *int      `db:"subscription_id"`
+	ServiceName    *string   `db:"service_name"`
+	UserID         uuid.UUID `db:"user_id"`
+	Date           time.Time `db:"charged_on"`
+	Description    string    `db:"description"`
+	// TerminationFee marks the fee for ending the contract of the
+	// subscription early; there is at most one per subscription.
+	TerminationFee bool `db:"termination_fee"`
+	Money
+}
+
+// Validate checks the invariants of the charge and returns a
+// *ValidationError with every field that breaks one, or nil.
+func (c *OneOffCharge) Validate() error {
+	errs := &ValidationError{}
+
+	if c.UserID == uuid.Nil {
+		errs.Add("user_id", CodeRequired, ErrMissingUserID)
+	}
+	if c.Amount < 0 {
+		errs.Add("amount", CodeNegative, ErrNegativeAmount)
+	}
+	if !c.Currency.Valid() {
+		errs.Add("currency", CodeInvalid, ErrUnknownCurrency)
+	}
+	if strings.TrimSpace(c.Description) == "" {
+		errs.Add("description", CodeRequired, ErrNoDescription)
+	}
+
+	return errs.Err()
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Overlap is a pair of subscriptions of the same user to the same service
+// whose periods intersect from From until To, or for good when To is nil.
+type Overlap struct {
+	UserID      uuid.UUID  `db:"user_id"`
+	ServiceName string     `db:"service_name"`
+	FirstID     int        `db:"first_id"`
+	SecondID    int        `db:"second_id"`
+	From        time.Time  `db:"overlap_from"`
+	To          *time.Time `db:"overlap_to"`
+}
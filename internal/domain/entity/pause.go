@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// Pause is an interval during which a subscription is not charged. The
+// subscription is served again from ResumedAt on.
+type Pause struct {
+	ID             int        `db:"id"`
+	SubscriptionID int        `db:"subscription_id"`
+	PausedAt       time.Time  `db:"paused_at"`
+	ResumedAt      *time.Time `db:"resumed_at"`
+}
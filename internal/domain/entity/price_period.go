@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// PricePeriod is a subscription price in effect from EffectiveFrom until the
+// next period starts.
+type PricePeriod struct {
+	ID             int       `db:"id"`
+	SubscriptionID int       `db:"subscription_id"`
+	Price          int       `db:"price"`
+	EffectiveFrom  time.Time `db:"effective_from"`
+}
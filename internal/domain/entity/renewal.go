@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// Renewal is a charge of a subscription falling due on Date. Money is the
+// price in effect on that day.
+type Renewal struct {
+	SubscriptionID int       `db:"subscription_id"`
+	ServiceName    string    `db:"service_name"`
+	Date           time.Time `db:"date"`
+	Money
+}
+
+// NoticeDeadline is the last day to cancel a contract before it renews on
+// RenewsOn.
+type NoticeDeadline struct {
+	Subscription
+	Deadline time.Time
+	RenewsOn time.Time
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// SeatPeriod is a subscription seat count in effect from EffectiveFrom until
+// the next period starts.
+type SeatPeriod struct {
+	ID             int       `db:"id"`
+	SubscriptionID int       `db:"subscription_id"`
+	Seats          int       `db:"seats"`
+	EffectiveFrom  time.Time `db:"effective_from"`
+}
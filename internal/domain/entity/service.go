@@ -0,0 +1,25 @@
+package entity
+
+import "strings"
+
+// Service is a catalog entry: the canonical name subscriptions to a service
+// are filed under, and the other names it is also known by.
+type Service struct {
+	ID      int
+	Name    string
+	Aliases []string
+}
+
+// KnownAs reports whether the service goes by name, its own or one of its
+// aliases, in any case.
+func (s *Service) KnownAs(name string) bool {
+	if strings.EqualFold(s.Name, name) {
+		return true
+	}
+	for _, a := range s.Aliases {
+		if strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
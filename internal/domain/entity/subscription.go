@@ -1,16 +1,157 @@
 package entity
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrNegativePrice      = errors.New("price must not be negative")
+	ErrNegativeTrialPrice = errors.New("trial_price must not be negative")
+	ErrNoSeats            = errors.New("seats must be at least 1")
+	ErrMissingUserID      = errors.New("user_id must not be empty")
+	ErrUnknownCurrency    = errors.New("currency must be a valid ISO 4217 code")
+	ErrUnknownPeriod      = errors.New("billing_period must be one of weekly, monthly, quarterly, yearly")
+	ErrEndBeforeStart     = errors.New("end_date must not be before start_date")
+	ErrTrialBeforeStart   = errors.New("trial_end_date must not be before start_date")
+	ErrNoMinimumTerm      = errors.New("minimum_term_months must be at least 1")
+	ErrNoNoticePeriod     = errors.New("notice_period_days must be at least 1")
+)
+
 type Subscription struct {
-	ID          int        `db:"id"`
-	ServiceName string     `db:"service_name"`
-	Price       int        `db:"price"`
-	UserID      uuid.UUID  `db:"user_id"`
-	StartDate   time.Time  `db:"start_date"`
-	EndDate     *time.Time `db:"end_date"`
+	ID            int                `db:"id"`
+	ServiceID     *int               `db:"service_id"`
+	ServiceName   string             `db:"service_name"`
+	Category      *string            `db:"category"`
+	Tags          []string           `db:"-"`
+	Members       []Member           `db:"-"`
+	Allocations   []Allocation       `db:"-"`
+	Price         int                `db:"price"` // of one seat per billing period
+	Seats         int                `db:"seats"`
+	Currency      Currency           `db:"currency"`
+	BillingPeriod BillingPeriod      `db:"billing_period"`
+	UserID        uuid.UUID          `db:"user_id"`
+	StartDate     time.Time          `db:"start_date"`
+	EndDate       *time.Time         `db:"end_date"`
+	TrialEndDate  *time.Time         `db:"trial_end_date"`
+	TrialPrice    int                `db:"trial_price"`
+	PausedAt      *time.Time         `db:"paused_at"`
+	Approval      Approval           `db:"approval"`
+	VendorID      *int               `db:"vendor_id"`
+	VendorName    *string            `db:"vendor_name"`
+	Status        SubscriptionStatus `db:"status"`
+	NextRenewal   *time.Time         `db:"next_renewal"`
+
+	CancelledAt        *time.Time `db:"cancelled_at"`
+	CancellationReason *string    `db:"cancellation_reason"`
+
+	// The contract renews for another minimum term, or for another billing
+	// period without one, unless cancelled NoticePeriodDays before.
+	MinimumTermMonths *int       `db:"minimum_term_months"`
+	NoticePeriodDays  *int       `db:"notice_period_days"`
+	RenewalOwner      *uuid.UUID `db:"renewal_owner"`
+}
+
+// NewSubscription returns a copy of s once its invariants hold, or a
+// *ValidationError listing every one that does not.
+func NewSubscription(s Subscription) (*Subscription, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Validate checks the invariants of the subscription and returns a
+// *ValidationError with every field that breaks one, or nil.
+func (s *Subscription) Validate() error {
+	errs := &ValidationError{}
+
+	if s.UserID == uuid.Nil {
+		errs.Add("user_id", CodeRequired, ErrMissingUserID)
+	}
+	if s.Price < 0 {
+		errs.Add("price", CodeNegative, ErrNegativePrice)
+	}
+	if s.Seats < 1 {
+		errs.Add("seats", CodeInvalid, ErrNoSeats)
+	}
+	if s.TrialPrice < 0 {
+		errs.Add("trial_price", CodeNegative, ErrNegativeTrialPrice)
+	}
+	if !s.Currency.Valid() {
+		errs.Add("currency", CodeInvalid, ErrUnknownCurrency)
+	}
+	if !s.BillingPeriod.Valid() {
+		errs.Add("billing_period", CodeInvalid, ErrUnknownPeriod)
+	}
+	if s.EndDate != nil && s.EndDate.Before(s.StartDate) {
+		errs.Add("end_date", CodeBeforeStart, ErrEndBeforeStart)
+	}
+	if s.TrialEndDate != nil && s.TrialEndDate.Before(s.StartDate) {
+		errs.Add("trial_end_date", CodeBeforeStart, ErrTrialBeforeStart)
+	}
+	if s.MinimumTermMonths != nil && *s.MinimumTermMonths < 1 {
+		errs.Add("minimum_term_months", CodeInvalid, ErrNoMinimumTerm)
+	}
+	if s.NoticePeriodDays != nil && *s.NoticePeriodDays < 1 {
+		errs.Add("notice_period_days", CodeInvalid, ErrNoNoticePeriod)
+	}
+
+	return errs.Err()
+}
+
+// EffectivePrice is the price of all seats per billing period.
+func (s *Subscription) EffectivePrice() int {
+	return s.Price * s.Seats
+}
+
+// MonthlyPrice is the effective price normalised to one month of the billing
+// period.
+func (s *Subscription) MonthlyPrice() int {
+	return s.BillingPeriod.Monthly(s.EffectivePrice())
+}
+
+// CurrentPeriodEnd is the last day of the billing period that contains day.
+// Periods are counted from the start date.
+func (s *Subscription) CurrentPeriodEnd(day time.Time) time.Time {
+	n := 1
+	next := s.BillingPeriod.AddTo(s.StartDate, n)
+	for !next.After(day) {
+		n++
+		next = s.BillingPeriod.AddTo(s.StartDate, n)
+	}
+	return next.AddDate(0, 0, -1)
+}
+
+// termEnd is the day the n-th contract term after the start date ends and the
+// next one begins.
+func (s *Subscription) termEnd(n int) time.Time {
+	if s.MinimumTermMonths != nil {
+		months := *s.MinimumTermMonths
+		return BillingPeriodMonthly.AddTo(s.StartDate, n*months)
+	}
+	return s.BillingPeriod.AddTo(s.StartDate, n)
+}
+
+// NextNoticeDeadline is the first notice deadline on or after day. ok is
+// false without a notice period.
+func (s *Subscription) NextNoticeDeadline(day time.Time) (NoticeDeadline, bool) {
+	if s.NoticePeriodDays == nil {
+		return NoticeDeadline{}, false
+	}
+
+	n := 1
+	renewal := s.termEnd(n)
+	for renewal.AddDate(0, 0, -*s.NoticePeriodDays).Before(day) {
+		n++
+		renewal = s.termEnd(n)
+	}
+
+	return NoticeDeadline{
+		Subscription: *s,
+		Deadline:     renewal.AddDate(0, 0, -*s.NoticePeriodDays),
+		RenewsOn:     renewal,
+	}, true
 }
@@ -0,0 +1,15 @@
+package entity
+
+// SubscriptionStatus is the state of a subscription on the current day. It is
+// derived from its approval, dates and pauses, never stored.
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusUpcoming SubscriptionStatus = "upcoming"
+	SubscriptionStatusActive   SubscriptionStatus = "active"
+	SubscriptionStatusPaused   SubscriptionStatus = "paused"
+	SubscriptionStatusEnded    SubscriptionStatus = "ended"
+
+	SubscriptionStatusPendingApproval SubscriptionStatus = "pending_approval"
+	SubscriptionStatusRejected        SubscriptionStatus = "rejected"
+)
@@ -0,0 +1,69 @@
+package entity
+
+import "time"
+
+// TerminationFeeRule is how ending a contract before its minimum term is over
+// is priced.
+type TerminationFeeRule string
+
+const (
+	// TerminationFeeRemainingMonths charges the monthly price of every month
+	// left in the term, a started month counting in full.
+	TerminationFeeRemainingMonths TerminationFeeRule = "remaining_months"
+	// TerminationFeeFlat charges a fixed amount in the subscription's
+	// currency.
+	TerminationFeeFlat TerminationFeeRule = "flat"
+)
+
+func (r TerminationFeeRule) Valid() bool {
+	switch r {
+	case TerminationFeeRemainingMonths, TerminationFeeFlat:
+		return true
+	}
+	return false
+}
+
+// TerminationFeePolicy prices early terminations by Rule; FlatAmount is the
+// fee of the flat rule.
+type TerminationFeePolicy struct {
+	Rule       TerminationFeeRule
+	FlatAmount int
+}
+
+// Fee is the fee for s ending on end, its last day served. ok is false when s
+// has no minimum term, end is the last day of a term, or the fee comes to
+// nothing.
+func (p TerminationFeePolicy) Fee(s *Subscription, end time.Time) (Money, bool) {
+	if s.MinimumTermMonths == nil {
+		return Money{}, false
+	}
+
+	// the term end falls in is cut short unless the next one begins the day
+	// after
+	next := end.AddDate(0, 0, 1)
+	n := 1
+	for !s.termEnd(n).After(end) {
+		n++
+	}
+	termEnd := s.termEnd(n)
+	if !next.Before(termEnd) {
+		return Money{}, false
+	}
+
+	var amount int
+	switch p.Rule {
+	case TerminationFeeFlat:
+		amount = p.FlatAmount
+	default:
+		months := 0
+		for BillingPeriodMonthly.AddTo(next, months).Before(termEnd) {
+			months++
+		}
+		amount = s.MonthlyPrice() * months
+	}
+
+	if amount <= 0 {
+		return Money{}, false
+	}
+	return Money{Amount: amount, Currency: s.Currency}, true
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+// Codes of the field errors, stable for clients to match on.
+const (
+	CodeRequired    = "required"
+	CodeInvalid     = "invalid"
+	CodeNegative    = "negative"
+	CodeBeforeStart = "before_start"
+)
+
+// FieldError is one field breaking an invariant. Err carries the message and
+// can be matched with errors.Is.
+type FieldError struct {
+	Field string
+	Code  string
+	Err   error
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError lists every field error found at once, in the order they
+// were found.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Fields))
+	for i, f := range e.Fields {
+		errs[i] = f
+	}
+	return errs
+}
+
+// Add records a field error unless the field already has one: the first
+// problem found with a value is the one worth reporting.
+func (e *ValidationError) Add(field, code string, err error) {
+	for _, f := range e.Fields {
+		if f.Field == field {
+			return
+		}
+	}
+	e.Fields = append(e.Fields, FieldError{Field: field, Code: code, Err: err})
+}
+
+// Merge adds the fields of err if it is a ValidationError. Any other error,
+// nil included, is ignored.
+func (e *ValidationError) Merge(err error) {
+	var v *ValidationError
+	if errors.As(err, &v) {
+		for _, f := range v.Fields {
+			e.Add(f.Field, f.Code, f.Err)
+		}
+	}
+}
+
+// Err returns e, or nil when no field error was added.
+func (e *ValidationError) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
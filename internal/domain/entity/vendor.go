@@ -0,0 +1,11 @@
+package entity
+
+// Vendor is a company subscriptions are bought from, with the people to talk
+// to about the contracts.
+type Vendor struct {
+	ID             int     `db:"id"`
+	Name           string  `db:"name"`
+	Contact        *string `db:"contact"`
+	AccountManager *string `db:"account_manager"`
+	Notes          *string `db:"notes"`
+}
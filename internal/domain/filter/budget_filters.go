@@ -0,0 +1,10 @@
+package filter
+
+import "github.com/google/uuid"
+
+// BudgetFilter selects budgets; a zero Limit lists them all.
+type BudgetFilter struct {
+	UserID *uuid.UUID
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,25 @@
+package filter
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChargeFilter struct {
+	UserID         *uuid.UUID
+	SubscriptionID *int
+	StartDate      *time.Time
+	EndDate        *time.Time
+	Limit          int
+	Offset         int
+}
+
+type OneOffChargeFilter struct {
+	UserID         *uuid.UUID
+	SubscriptionID *int
+	StartDate      *time.Time
+	EndDate        *time.Time
+	Limit          int
+	Offset         int
+}
@@ -0,0 +1,16 @@
+package filter
+
+type ServiceFilter struct {
+	Limit  int
+	Offset int
+}
+
+type CostCentreFilter struct {
+	Limit  int
+	Offset int
+}
+
+type VendorFilter struct {
+	Limit  int
+	Offset int
+}
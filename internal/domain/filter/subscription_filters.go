@@ -3,19 +3,65 @@ package filter
 import (
 	"time"
 
+	"github.com/maket12/SubTrack/internal/domain/entity"
+
 	"github.com/google/uuid"
 )
 
+// ListFilter selects subscriptions. ServiceName also matches subscriptions
+// filed under the catalog entry known by that name or alias; Category and Tag
+// match in any case.
 type ListFilter struct {
-	UserID      *uuid.UUID
-	ServiceName *string
-	Limit       int
-	Offset      int
+	UserID         *uuid.UUID
+	ServiceName    *string
+	Category       *string
+	Tag            *string
+	Currency       *entity.Currency
+	InTrial        *bool
+	Cancelled      *bool
+	Active         *bool
+	ScheduledToEnd *bool
+	Pending        *bool
+	Limit          int
+	Offset         int
 }
 
+// SumGroup is a key a spending breakdown can be grouped by.
+type SumGroup string
+
+const (
+	SumGroupServiceName SumGroup = "service_name"
+	SumGroupUserID      SumGroup = "user_id"
+	SumGroupMonth       SumGroup = "month"
+)
+
+// SumFilter selects the subscriptions summed up. ServiceName, Category and Tag
+// match the same way as in ListFilter.
 type SumFilter struct {
 	UserID      *uuid.UUID
 	ServiceName *string
+	Category    *string
+	Tag         *string
 	StartDate   *time.Time
 	EndDate     *time.Time
+	GroupBy     []SumGroup
+}
+
+type DuplicateFilter struct {
+	UserID *uuid.UUID
+	Limit  int
+	Offset int
+}
+
+type RenewalFilter struct {
+	UserID *uuid.UUID
+	From   time.Time
+	To     time.Time
+}
+
+// ContractFilter selects the contracts that renew unless notice is given:
+// approved subscriptions with a notice period and no end date.
+type ContractFilter struct {
+	RenewalOwner *uuid.UUID
+	VendorID     *int
 }
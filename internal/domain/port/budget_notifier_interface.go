@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+)
+
+type BudgetNotifier interface {
+	// BudgetExceeded delivers the alert. It is called while a request is
+	// served, so implementations must not block on slow deliveries and deal
+	// with their own failures.
+	BudgetExceeded(ctx context.Context, alert entity.BudgetAlert)
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+)
+
+type BudgetRepository interface {
+	Create(ctx context.Context, b *entity.Budget) (int, error)
+	Get(ctx context.Context, id int) (*entity.Budget, error)
+	Update(ctx context.Context, b *entity.Budget) error
+	Delete(ctx context.Context, id int) error
+	GetList(ctx context.Context, filter filter.BudgetFilter) ([]entity.Budget, error)
+}
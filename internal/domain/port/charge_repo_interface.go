@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+)
+
+type ChargeRepository interface {
+	// Sync regenerates the charges of one subscription from today up to the
+	// given day, keeping the ones already due.
+	Sync(ctx context.Context, subscriptionID int, until time.Time) error
+	// Extend appends the charges every subscription falls due for after its
+	// last recorded one, up to the given day, and returns how many it added.
+	Extend(ctx context.Context, until time.Time) (int, error)
+	GetList(ctx context.Context, filter filter.ChargeFilter) ([]entity.Charge, error)
+}
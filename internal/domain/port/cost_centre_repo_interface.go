@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+)
+
+type CostCentreRepository interface {
+	Create(ctx context.Context, c *entity.CostCentre) (int, error)
+	Get(ctx context.Context, id int) (*entity.CostCentre, error)
+	// FindByName finds the cost centre with the name in any case, or returns
+	// sql.ErrNoRows.
+	FindByName(ctx context.Context, name string) (*entity.CostCentre, error)
+	Update(ctx context.Context, c *entity.CostCentre) error
+	Delete(ctx context.Context, id int) error
+	GetList(ctx context.Context, filter filter.CostCentreFilter) ([]entity.CostCentre, error)
+}
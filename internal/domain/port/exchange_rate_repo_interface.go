@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+)
+
+type ExchangeRateRepository interface {
+	Save(ctx context.Context, rates []entity.ExchangeRate) error
+	GetRate(ctx context.Context, from, to entity.Currency, on time.Time) (float64, error)
+}
@@ -0,0 +1,109 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/maket12/SubTrack/internal/domain/entity"
+	filter "github.com/maket12/SubTrack/internal/domain/filter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ChargeRepository is an autogenerated mock type for the ChargeRepository type
+type ChargeRepository struct {
+	mock.Mock
+}
+
+// Extend provides a mock function with given fields: ctx, until
+func (_m *ChargeRepository) Extend(ctx context.Context, until time.Time) (int, error) {
+	ret := _m.Called(ctx, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Extend")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, until)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, _a1
+func (_m *ChargeRepository) GetList(ctx context.Context, _a1 filter.ChargeFilter) ([]entity.Charge, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetList")
+	}
+
+	var r0 []entity.Charge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.ChargeFilter) ([]entity.Charge, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.ChargeFilter) []entity.Charge); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Charge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.ChargeFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Sync provides a mock function with given fields: ctx, subscriptionID, until
+func (_m *ChargeRepository) Sync(ctx context.Context, subscriptionID int, until time.Time) error {
+	ret := _m.Called(ctx, subscriptionID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sync")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, subscriptionID, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewChargeRepository creates a new instance of ChargeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChargeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChargeRepository {
+	mock := &ChargeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
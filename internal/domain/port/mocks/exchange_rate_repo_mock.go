@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/maket12/SubTrack/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ExchangeRateRepository is an autogenerated mock type for the ExchangeRateRepository type
+type ExchangeRateRepository struct {
+	mock.Mock
+}
+
+// GetRate provides a mock function with given fields: ctx, from, to, on
+func (_m *ExchangeRateRepository) GetRate(ctx context.Context, from entity.Currency, to entity.Currency, on time.Time) (float64, error) {
+	ret := _m.Called(ctx, from, to, on)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRate")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Currency, entity.Currency, time.Time) (float64, error)); ok {
+		return rf(ctx, from, to, on)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Currency, entity.Currency, time.Time) float64); ok {
+		r0 = rf(ctx, from, to, on)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Currency, entity.Currency, time.Time) error); ok {
+		r1 = rf(ctx, from, to, on)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: ctx, rates
+func (_m *ExchangeRateRepository) Save(ctx context.Context, rates []entity.ExchangeRate) error {
+	ret := _m.Called(ctx, rates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.ExchangeRate) error); ok {
+		r0 = rf(ctx, rates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewExchangeRateRepository creates a new instance of ExchangeRateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExchangeRateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExchangeRateRepository {
+	mock := &ExchangeRateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,6 +9,10 @@ import (
 	filter "github.com/maket12/SubTrack/internal/domain/filter"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
 )
 
 // SubscriptionRepository is an autogenerated mock type for the SubscriptionRepository type
@@ -16,6 +20,78 @@ type SubscriptionRepository struct {
 	mock.Mock
 }
 
+// AddMember provides a mock function with given fields: ctx, m
+func (_m *SubscriptionRepository) AddMember(ctx context.Context, m entity.Member) error {
+	ret := _m.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Member) error); ok {
+		r0 = rf(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Cancel provides a mock function with given fields: ctx, s, fee
+func (_m *SubscriptionRepository) Cancel(ctx context.Context, s *entity.Subscription, fee *entity.OneOffCharge) error {
+	ret := _m.Called(ctx, s, fee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Subscription, *entity.OneOffCharge) error); ok {
+		r0 = rf(ctx, s, fee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChangePrice provides a mock function with given fields: ctx, p
+func (_m *SubscriptionRepository) ChangePrice(ctx context.Context, p entity.PricePeriod) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.PricePeriod) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChangeSeats provides a mock function with given fields: ctx, p
+func (_m *SubscriptionRepository) ChangeSeats(ctx context.Context, p entity.SeatPeriod) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeSeats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SeatPeriod) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, s
 func (_m *SubscriptionRepository) Create(ctx context.Context, s *entity.Subscription) (int, error) {
 	ret := _m.Called(ctx, s)
@@ -43,6 +119,24 @@ func (_m *SubscriptionRepository) Create(ctx context.Context, s *entity.Subscrip
 	return r0, r1
 }
 
+// Decide provides a mock function with given fields: ctx, d
+func (_m *SubscriptionRepository) Decide(ctx context.Context, d *entity.ApprovalDecision) error {
+	ret := _m.Called(ctx, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decide")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ApprovalDecision) error); ok {
+		r0 = rf(ctx, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *SubscriptionRepository) Delete(ctx context.Context, id int) error {
 	ret := _m.Called(ctx, id)
@@ -61,6 +155,34 @@ func (_m *SubscriptionRepository) Delete(ctx context.Context, id int) error {
 	return r0
 }
 
+// FindOverlap provides a mock function with given fields: ctx, s
+func (_m *SubscriptionRepository) FindOverlap(ctx context.Context, s *entity.Subscription) (int, error) {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOverlap")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Subscription) (int, error)); ok {
+		return rf(ctx, s)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Subscription) int); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Subscription) error); ok {
+		r1 = rf(ctx, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *SubscriptionRepository) Get(ctx context.Context, id int) (*entity.Subscription, error) {
 	ret := _m.Called(ctx, id)
@@ -91,6 +213,156 @@ func (_m *SubscriptionRepository) Get(ctx context.Context, id int) (*entity.Subs
 	return r0, r1
 }
 
+// GetApprovals provides a mock function with given fields: ctx, id
+func (_m *SubscriptionRepository) GetApprovals(ctx context.Context, id int) ([]entity.ApprovalDecision, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApprovals")
+	}
+
+	var r0 []entity.ApprovalDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]entity.ApprovalDecision, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []entity.ApprovalDecision); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ApprovalDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChargeback provides a mock function with given fields: ctx, _a1
+func (_m *SubscriptionRepository) GetChargeback(ctx context.Context, _a1 filter.SumFilter) ([]entity.ChargebackAmount, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChargeback")
+	}
+
+	var r0 []entity.ChargebackAmount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) ([]entity.ChargebackAmount, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) []entity.ChargebackAmount); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ChargebackAmount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.SumFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetContracts provides a mock function with given fields: ctx, _a1
+func (_m *SubscriptionRepository) GetContracts(ctx context.Context, _a1 filter.ContractFilter) ([]entity.Subscription, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContracts")
+	}
+
+	var r0 []entity.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.ContractFilter) ([]entity.Subscription, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.ContractFilter) []entity.Subscription); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.ContractFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForecast provides a mock function with given fields: ctx, _a1
+func (_m *SubscriptionRepository) GetForecast(ctx context.Context, _a1 filter.SumFilter) ([]entity.ForecastAmount, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForecast")
+	}
+
+	var r0 []entity.ForecastAmount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) ([]entity.ForecastAmount, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) []entity.ForecastAmount); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ForecastAmount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.SumFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGroupedSums provides a mock function with given fields: ctx, _a1
+func (_m *SubscriptionRepository) GetGroupedSums(ctx context.Context, _a1 filter.SumFilter) ([]entity.GroupedAmount, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupedSums")
+	}
+
+	var r0 []entity.GroupedAmount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) ([]entity.GroupedAmount, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) []entity.GroupedAmount); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.GroupedAmount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.SumFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetList provides a mock function with given fields: ctx, _a1
 func (_m *SubscriptionRepository) GetList(ctx context.Context, _a1 filter.ListFilter) ([]entity.Subscription, error) {
 	ret := _m.Called(ctx, _a1)
@@ -121,23 +393,175 @@ func (_m *SubscriptionRepository) GetList(ctx context.Context, _a1 filter.ListFi
 	return r0, r1
 }
 
+// GetMonthlySeries provides a mock function with given fields: ctx, _a1
+func (_m *SubscriptionRepository) GetMonthlySeries(ctx context.Context, _a1 filter.SumFilter) ([]entity.MonthlyAmount, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMonthlySeries")
+	}
+
+	var r0 []entity.MonthlyAmount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) ([]entity.MonthlyAmount, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) []entity.MonthlyAmount); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MonthlyAmount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.SumFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMonthlySums provides a mock function with given fields: ctx, _a1
+func (_m *SubscriptionRepository) GetMonthlySums(ctx context.Context, _a1 filter.SumFilter) ([]entity.MonthlyAmount, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMonthlySums")
+	}
+
+	var r0 []entity.MonthlyAmount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) ([]entity.MonthlyAmount, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) []entity.MonthlyAmount); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MonthlyAmount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.SumFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOverlaps provides a mock function with given fields: ctx, _a1
+func (_m *SubscriptionRepository) GetOverlaps(ctx context.Context, _a1 filter.DuplicateFilter) ([]entity.Overlap, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverlaps")
+	}
+
+	var r0 []entity.Overlap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.DuplicateFilter) ([]entity.Overlap, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.DuplicateFilter) []entity.Overlap); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Overlap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.DuplicateFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceHistory provides a mock function with given fields: ctx, id
+func (_m *SubscriptionRepository) GetPriceHistory(ctx context.Context, id int) ([]entity.PricePeriod, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 []entity.PricePeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]entity.PricePeriod, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []entity.PricePeriod); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PricePeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRenewals provides a mock function with given fields: ctx, _a1
+func (_m *SubscriptionRepository) GetRenewals(ctx context.Context, _a1 filter.RenewalFilter) ([]entity.Renewal, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRenewals")
+	}
+
+	var r0 []entity.Renewal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.RenewalFilter) ([]entity.Renewal, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.RenewalFilter) []entity.Renewal); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Renewal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.RenewalFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTotalSum provides a mock function with given fields: ctx, _a1
-func (_m *SubscriptionRepository) GetTotalSum(ctx context.Context, _a1 filter.SumFilter) (int, error) {
+func (_m *SubscriptionRepository) GetTotalSum(ctx context.Context, _a1 filter.SumFilter) ([]entity.Money, error) {
 	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTotalSum")
 	}
 
-	var r0 int
+	var r0 []entity.Money
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) (int, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) ([]entity.Money, error)); ok {
 		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) int); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, filter.SumFilter) []entity.Money); ok {
 		r0 = rf(ctx, _a1)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Money)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, filter.SumFilter) error); ok {
@@ -149,6 +573,78 @@ func (_m *SubscriptionRepository) GetTotalSum(ctx context.Context, _a1 filter.Su
 	return r0, r1
 }
 
+// Pause provides a mock function with given fields: ctx, p
+func (_m *SubscriptionRepository) Pause(ctx context.Context, p entity.Pause) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pause")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Pause) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveMember provides a mock function with given fields: ctx, subscriptionID, userID
+func (_m *SubscriptionRepository) RemoveMember(ctx context.Context, subscriptionID int, userID uuid.UUID) error {
+	ret := _m.Called(ctx, subscriptionID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, uuid.UUID) error); ok {
+		r0 = rf(ctx, subscriptionID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Resume provides a mock function with given fields: ctx, id, resumedAt
+func (_m *SubscriptionRepository) Resume(ctx context.Context, id int, resumedAt time.Time) error {
+	ret := _m.Called(ctx, id, resumedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, id, resumedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetAllocations provides a mock function with given fields: ctx, id, allocations
+func (_m *SubscriptionRepository) SetAllocations(ctx context.Context, id int, allocations []entity.Allocation) error {
+	ret := _m.Called(ctx, id, allocations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAllocations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []entity.Allocation) error); ok {
+		r0 = rf(ctx, id, allocations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, s
 func (_m *SubscriptionRepository) Update(ctx context.Context, s *entity.Subscription) error {
 	ret := _m.Called(ctx, s)
@@ -167,6 +663,24 @@ func (_m *SubscriptionRepository) Update(ctx context.Context, s *entity.Subscrip
 	return r0
 }
 
+// UpdateContract provides a mock function with given fields: ctx, s, fee
+func (_m *SubscriptionRepository) UpdateContract(ctx context.Context, s *entity.Subscription, fee *entity.OneOffCharge) error {
+	ret := _m.Called(ctx, s, fee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContract")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Subscription, *entity.OneOffCharge) error); ok {
+		r0 = rf(ctx, s, fee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewSubscriptionRepository creates a new instance of SubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSubscriptionRepository(t interface {
@@ -0,0 +1,185 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/maket12/SubTrack/internal/domain/entity"
+	filter "github.com/maket12/SubTrack/internal/domain/filter"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// VendorRepository is an autogenerated mock type for the VendorRepository type
+type VendorRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, v
+func (_m *VendorRepository) Create(ctx context.Context, v *entity.Vendor) (int, error) {
+	ret := _m.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Vendor) (int, error)); ok {
+		return rf(ctx, v)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Vendor) int); ok {
+		r0 = rf(ctx, v)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Vendor) error); ok {
+		r1 = rf(ctx, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *VendorRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByName provides a mock function with given fields: ctx, name
+func (_m *VendorRepository) FindByName(ctx context.Context, name string) (*entity.Vendor, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByName")
+	}
+
+	var r0 *entity.Vendor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Vendor, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Vendor); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Vendor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *VendorRepository) Get(ctx context.Context, id int) (*entity.Vendor, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *entity.Vendor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*entity.Vendor, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *entity.Vendor); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Vendor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, _a1
+func (_m *VendorRepository) GetList(ctx context.Context, _a1 filter.VendorFilter) ([]entity.Vendor, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetList")
+	}
+
+	var r0 []entity.Vendor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, filter.VendorFilter) ([]entity.Vendor, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, filter.VendorFilter) []entity.Vendor); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Vendor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, filter.VendorFilter) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, v
+func (_m *VendorRepository) Update(ctx context.Context, v *entity.Vendor) error {
+	ret := _m.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Vendor) error); ok {
+		r0 = rf(ctx, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewVendorRepository creates a new instance of VendorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVendorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VendorRepository {
+	mock := &VendorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+)
+
+type OneOffChargeRepository interface {
+	Create(ctx context.Context, c *entity.OneOffCharge) (int, error)
+	Get(ctx context.Context, id int) (*entity.OneOffCharge, error)
+	// Update and Delete leave early termination fees alone, reporting them
+	// as sql.ErrNoRows.
+	Update(ctx context.Context, c *entity.OneOffCharge) error
+	Delete(ctx context.Context, id int) error
+	GetList(ctx context.Context, filter filter.OneOffChargeFilter) ([]entity.OneOffCharge, error)
+}
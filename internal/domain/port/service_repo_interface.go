@@ -0,0 +1,21 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+)
+
+type ServiceRepository interface {
+	Create(ctx context.Context, s *entity.Service) (int, error)
+	Get(ctx context.Context, id int) (*entity.Service, error)
+	// Resolve finds the service known by the name, either its own or one of
+	// its aliases, in any case.
+	Resolve(ctx context.Context, name string) (*entity.Service, error)
+	// Update renames the service together with its subscriptions and
+	// replaces its aliases.
+	Update(ctx context.Context, s *entity.Service) error
+	Delete(ctx context.Context, id int) error
+	GetList(ctx context.Context, filter filter.ServiceFilter) ([]entity.Service, error)
+}
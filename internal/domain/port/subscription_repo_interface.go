@@ -2,16 +2,55 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/maket12/SubTrack/internal/domain/entity"
 	"github.com/maket12/SubTrack/internal/domain/filter"
+
+	"github.com/google/uuid"
 )
 
 type SubscriptionRepository interface {
 	Create(ctx context.Context, s *entity.Subscription) (int, error)
 	Get(ctx context.Context, id int) (*entity.Subscription, error)
 	Update(ctx context.Context, s *entity.Subscription) error
+	// UpdateContract updates the subscription and replaces its early
+	// termination fee in one go; a nil fee removes it.
+	UpdateContract(ctx context.Context, s *entity.Subscription, fee *entity.OneOffCharge) error
+	ChangePrice(ctx context.Context, p entity.PricePeriod) error
+	GetPriceHistory(ctx context.Context, id int) ([]entity.PricePeriod, error)
+	ChangeSeats(ctx context.Context, p entity.SeatPeriod) error
+	// Pause opens a pause, or returns sql.ErrNoRows when it would start
+	// inside an earlier one.
+	Pause(ctx context.Context, p entity.Pause) error
+	Resume(ctx context.Context, id int, resumedAt time.Time) error
+	// Cancel records the cancellation and replaces the early termination fee
+	// in one go; a nil fee removes it.
+	Cancel(ctx context.Context, s *entity.Subscription, fee *entity.OneOffCharge) error
+	// AddMember adds a member to a subscription, or changes the share of
+	// one already there.
+	AddMember(ctx context.Context, m entity.Member) error
+	RemoveMember(ctx context.Context, subscriptionID int, userID uuid.UUID) error
+	// SetAllocations replaces the cost centre allocations of a subscription.
+	SetAllocations(ctx context.Context, id int, allocations []entity.Allocation) error
+	// Decide records the decision on a pending subscription, filling in its
+	// id and time, or returns sql.ErrNoRows when the subscription is not
+	// pending.
+	Decide(ctx context.Context, d *entity.ApprovalDecision) error
+	GetApprovals(ctx context.Context, id int) ([]entity.ApprovalDecision, error)
 	Delete(ctx context.Context, id int) error
 	GetList(ctx context.Context, filter filter.ListFilter) ([]entity.Subscription, error)
-	GetTotalSum(ctx context.Context, filter filter.SumFilter) (int, error)
+	GetTotalSum(ctx context.Context, filter filter.SumFilter) ([]entity.Money, error)
+	GetMonthlySums(ctx context.Context, filter filter.SumFilter) ([]entity.MonthlyAmount, error)
+	GetGroupedSums(ctx context.Context, filter filter.SumFilter) ([]entity.GroupedAmount, error)
+	GetMonthlySeries(ctx context.Context, filter filter.SumFilter) ([]entity.MonthlyAmount, error)
+	GetForecast(ctx context.Context, filter filter.SumFilter) ([]entity.ForecastAmount, error)
+	GetRenewals(ctx context.Context, filter filter.RenewalFilter) ([]entity.Renewal, error)
+	GetChargeback(ctx context.Context, filter filter.SumFilter) ([]entity.ChargebackAmount, error)
+	GetContracts(ctx context.Context, filter filter.ContractFilter) ([]entity.Subscription, error)
+	// FindOverlap returns the id of a subscription of the same user and
+	// service as s whose period intersects the one of s, or sql.ErrNoRows.
+	// Rejected subscriptions are left out.
+	FindOverlap(ctx context.Context, s *entity.Subscription) (int, error)
+	GetOverlaps(ctx context.Context, filter filter.DuplicateFilter) ([]entity.Overlap, error)
 }
@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/maket12/SubTrack/internal/domain/entity"
+	"github.com/maket12/SubTrack/internal/domain/filter"
+)
+
+type VendorRepository interface {
+	Create(ctx context.Context, v *entity.Vendor) (int, error)
+	Get(ctx context.Context, id int) (*entity.Vendor, error)
+	// FindByName finds the vendor with the name in any case, or returns
+	// sql.ErrNoRows.
+	FindByName(ctx context.Context, name string) (*entity.Vendor, error)
+	Update(ctx context.Context, v *entity.Vendor) error
+	Delete(ctx context.Context, id int) error
+	GetList(ctx context.Context, filter filter.VendorFilter) ([]entity.Vendor, error)
+}
@@ -0,0 +1,5 @@
+ALTER TABLE subscriptions
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB'
+        CHECK (currency ~ '^[A-Z]{3}$');
+
+CREATE INDEX idx_sub_user_currency ON subscriptions(user_id, currency);
@@ -0,0 +1,8 @@
+CREATE TABLE exchange_rates
+(
+    rate_date DATE            NOT NULL,
+    base      CHAR(3)         NOT NULL,
+    quote     CHAR(3)         NOT NULL,
+    rate      NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    PRIMARY KEY (base, quote, rate_date)
+);
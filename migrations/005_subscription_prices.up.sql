@@ -0,0 +1,12 @@
+CREATE TABLE subscription_prices
+(
+    id              INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id INT  NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    price           INT  NOT NULL,
+    effective_from  DATE NOT NULL,
+    UNIQUE (subscription_id, effective_from)
+);
+
+INSERT INTO subscription_prices (subscription_id, price, effective_from)
+SELECT id, price, start_date
+FROM subscriptions;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_sub_trial_end_date;
+
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS trial_end_date,
+    DROP COLUMN IF EXISTS trial_price;
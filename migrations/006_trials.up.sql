@@ -0,0 +1,6 @@
+ALTER TABLE subscriptions
+    ADD COLUMN trial_end_date DATE,
+    ADD COLUMN trial_price    INT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_sub_trial_end_date ON subscriptions(trial_end_date)
+    WHERE trial_end_date IS NOT NULL;
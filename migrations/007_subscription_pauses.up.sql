@@ -0,0 +1,12 @@
+CREATE TABLE subscription_pauses
+(
+    id              INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id INT  NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    paused_at       DATE NOT NULL,
+    resumed_at      DATE,
+    CHECK (resumed_at IS NULL OR resumed_at >= paused_at)
+);
+
+CREATE INDEX idx_pause_subscription ON subscription_pauses(subscription_id);
+CREATE UNIQUE INDEX idx_pause_open ON subscription_pauses(subscription_id)
+    WHERE resumed_at IS NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_sub_cancelled_at;
+
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS cancellation_reason;
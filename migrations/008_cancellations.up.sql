@@ -0,0 +1,6 @@
+ALTER TABLE subscriptions
+    ADD COLUMN cancelled_at        TIMESTAMPTZ,
+    ADD COLUMN cancellation_reason TEXT;
+
+CREATE INDEX idx_sub_cancelled_at ON subscriptions(cancelled_at)
+    WHERE cancelled_at IS NOT NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE charges
+(
+    id              INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id INT     NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    charge_date     DATE    NOT NULL,
+    amount          INT     NOT NULL,
+    currency        CHAR(3) NOT NULL,
+    UNIQUE (subscription_id, charge_date)
+);
+
+CREATE INDEX idx_charge_date ON charges(charge_date);
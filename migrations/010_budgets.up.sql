@@ -0,0 +1,10 @@
+CREATE TABLE budgets
+(
+    id            INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id       UUID    NOT NULL,
+    service_name  TEXT,
+    monthly_limit INT     NOT NULL CHECK (monthly_limit >= 0),
+    currency      CHAR(3) NOT NULL
+);
+
+CREATE INDEX idx_budget_user ON budgets(user_id);
@@ -0,0 +1,32 @@
+CREATE TABLE services
+(
+    id   INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_service_name ON services(lower(name));
+
+CREATE TABLE service_aliases
+(
+    service_id INT  NOT NULL REFERENCES services (id) ON DELETE CASCADE,
+    alias      TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_service_alias ON service_aliases(lower(alias));
+
+-- every spelling already in use becomes one catalog entry
+INSERT INTO services (name)
+SELECT DISTINCT ON (lower(service_name)) service_name
+FROM subscriptions
+ORDER BY lower(service_name), service_name;
+
+ALTER TABLE subscriptions
+    ADD COLUMN service_id INT REFERENCES services (id) ON DELETE SET NULL;
+
+UPDATE subscriptions s
+SET service_id   = sv.id,
+    service_name = sv.name
+FROM services sv
+WHERE lower(sv.name) = lower(s.service_name);
+
+CREATE INDEX idx_sub_service ON subscriptions(service_id);
@@ -0,0 +1,4 @@
+ALTER TABLE budgets DROP COLUMN IF EXISTS category;
+DROP TABLE IF EXISTS subscription_tags;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS category_id;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,24 @@
+CREATE TABLE categories
+(
+    id   INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_category_name ON categories(lower(name));
+
+ALTER TABLE subscriptions
+    ADD COLUMN category_id INT REFERENCES categories (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_sub_category ON subscriptions(category_id);
+
+CREATE TABLE subscription_tags
+(
+    subscription_id INT  NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    tag             TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_sub_tag ON subscription_tags(subscription_id, lower(tag));
+CREATE INDEX idx_tag ON subscription_tags(lower(tag));
+
+ALTER TABLE budgets
+    ADD COLUMN category TEXT;
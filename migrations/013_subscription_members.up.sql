@@ -0,0 +1,11 @@
+CREATE TABLE subscription_members
+(
+    subscription_id INT  NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    user_id         UUID NOT NULL,
+    share_percent   NUMERIC(5, 2) CHECK (share_percent > 0 AND share_percent <= 100),
+    share_amount    INT CHECK (share_amount >= 0),
+    PRIMARY KEY (subscription_id, user_id),
+    CHECK ((share_percent IS NULL) <> (share_amount IS NULL))
+);
+
+CREATE INDEX idx_member_user ON subscription_members(user_id);
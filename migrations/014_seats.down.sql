@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS subscription_seats;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS seats;
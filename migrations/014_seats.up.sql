@@ -0,0 +1,15 @@
+ALTER TABLE subscriptions
+    ADD COLUMN seats INT NOT NULL DEFAULT 1 CHECK (seats > 0);
+
+CREATE TABLE subscription_seats
+(
+    id              INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id INT  NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    seats           INT  NOT NULL CHECK (seats > 0),
+    effective_from  DATE NOT NULL,
+    UNIQUE (subscription_id, effective_from)
+);
+
+INSERT INTO subscription_seats (subscription_id, seats, effective_from)
+SELECT id, seats, start_date
+FROM subscriptions;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS subscription_allocations;
+DROP TABLE IF EXISTS cost_centres;
@@ -0,0 +1,18 @@
+CREATE TABLE cost_centres
+(
+    id   INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name TEXT NOT NULL,
+    code TEXT
+);
+
+CREATE UNIQUE INDEX idx_cost_centre_name ON cost_centres(lower(name));
+
+CREATE TABLE subscription_allocations
+(
+    subscription_id INT           NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    cost_centre_id  INT           NOT NULL REFERENCES cost_centres (id) ON DELETE CASCADE,
+    percent         NUMERIC(5, 2) NOT NULL CHECK (percent > 0 AND percent <= 100),
+    PRIMARY KEY (subscription_id, cost_centre_id)
+);
+
+CREATE INDEX idx_allocation_cost_centre ON subscription_allocations(cost_centre_id);
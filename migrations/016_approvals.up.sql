@@ -0,0 +1,18 @@
+ALTER TABLE subscriptions
+    ADD COLUMN approval TEXT NOT NULL DEFAULT 'approved'
+        CHECK (approval IN ('pending', 'approved', 'rejected'));
+
+CREATE INDEX idx_sub_approval_pending ON subscriptions(id)
+    WHERE approval = 'pending';
+
+CREATE TABLE subscription_approvals
+(
+    id              INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id INT         NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    decision        TEXT        NOT NULL CHECK (decision IN ('approved', 'rejected')),
+    decided_by      UUID        NOT NULL,
+    comment         TEXT,
+    decided_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_sub_approvals_subscription ON subscription_approvals(subscription_id);
@@ -0,0 +1,6 @@
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS vendor_id,
+    DROP COLUMN IF EXISTS minimum_term_months,
+    DROP COLUMN IF EXISTS notice_period_days,
+    DROP COLUMN IF EXISTS renewal_owner;
+DROP TABLE IF EXISTS vendors;
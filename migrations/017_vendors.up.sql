@@ -0,0 +1,18 @@
+CREATE TABLE vendors
+(
+    id              INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name            TEXT NOT NULL,
+    contact         TEXT,
+    account_manager TEXT,
+    notes           TEXT
+);
+
+CREATE UNIQUE INDEX idx_vendor_name ON vendors(lower(name));
+
+ALTER TABLE subscriptions
+    ADD COLUMN vendor_id           INT REFERENCES vendors (id) ON DELETE SET NULL,
+    ADD COLUMN minimum_term_months INT CHECK (minimum_term_months > 0),
+    ADD COLUMN notice_period_days  INT CHECK (notice_period_days > 0),
+    ADD COLUMN renewal_owner       UUID;
+
+CREATE INDEX idx_sub_vendor ON subscriptions(vendor_id);
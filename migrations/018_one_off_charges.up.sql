@@ -0,0 +1,18 @@
+CREATE TABLE one_off_charges
+(
+    id              INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    subscription_id INT     NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    user_id         UUID    NOT NULL,
+    charged_on      DATE    NOT NULL,
+    amount          INT     NOT NULL CHECK (amount >= 0),
+    currency        CHAR(3) NOT NULL,
+    description     TEXT    NOT NULL,
+    termination_fee BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX idx_one_off_subscription ON one_off_charges(subscription_id);
+CREATE INDEX idx_one_off_charged_on ON one_off_charges(charged_on);
+
+-- a contract ends once, so it has at most one early termination fee
+CREATE UNIQUE INDEX idx_one_off_termination_fee ON one_off_charges(subscription_id)
+    WHERE termination_fee;
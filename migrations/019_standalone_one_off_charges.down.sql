@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_one_off_user;
+DELETE FROM one_off_charges WHERE subscription_id IS NULL;
+ALTER TABLE one_off_charges
+    ALTER COLUMN subscription_id SET NOT NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE one_off_charges
+    ALTER COLUMN subscription_id DROP NOT NULL;
+
+CREATE INDEX idx_one_off_user ON one_off_charges(user_id, charged_on);